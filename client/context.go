@@ -353,11 +353,17 @@ func GetFromFields(kr keyring.Keyring, from string, genOnly bool) (sdk.AccAddres
 	return addr, k.Name, k.GetType(), nil
 }
 
-// NewKeyringFromBackend gets a Keyring object from a backend
+// NewKeyringFromBackend gets a Keyring object from a backend. The keyring
+// version is checked unless disabled by the context keyring options.
 func NewKeyringFromBackend(ctx Context, backend string) (keyring.Keyring, error) {
 	if ctx.GenerateOnly || ctx.Simulate {
 		backend = keyring.BackendMemory
 	}
 
-	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, ctx.KeyringOptions...)
+	opts := append([]keyring.Option{checkKeyringVersion}, ctx.KeyringOptions...)
+	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, ctx.Codec, opts...)
+}
+
+func checkKeyringVersion(options *keyring.Options) {
+	options.CheckVersion = true
 }
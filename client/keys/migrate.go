@@ -4,6 +4,12 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagDestKeyringBackend = "destination-keyring-backend"
+	flagDestKeyringDir     = "destination-keyring-dir"
 )
 
 // MigrateCommand migrates key information from legacy keybase to OS secret store.
@@ -20,11 +26,18 @@ outputted in CLI and migration will be continued until all keys in the keyring D
 See https://github.com/cosmos/cosmos-sdk/pull/9695 for more details.
 
 It is recommended to run in 'dry-run' mode first to verify all key migration material.
+
+If --destination-keyring-backend is set, all keys are instead copied into the given keyring backend,
+converting legacy entries on the way and leaving the source keyring unmodified. Nothing is copied if
+any key already exists in the destination keyring.
 `,
 		Args: cobra.NoArgs,
 		RunE: runMigrateCmd,
 	}
 
+	cmd.Flags().String(flagDestKeyringBackend, "", "Copy all keys into this keyring backend (os|file|kwallet|pass|test) instead of migrating them in place")
+	cmd.Flags().String(flagDestKeyringDir, "", "The destination keyring directory; if omitted, the source keyring directory will be used")
+
 	return cmd
 }
 
@@ -34,6 +47,27 @@ func runMigrateCmd(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	destBackend, _ := cmd.Flags().GetString(flagDestKeyringBackend)
+	if destBackend != "" {
+		destDir, _ := cmd.Flags().GetString(flagDestKeyringDir)
+		if destDir == "" {
+			destDir = clientCtx.KeyringDir
+		}
+
+		dest, err := client.NewKeyringFromBackend(clientCtx.WithKeyringDir(destDir).WithInput(cmd.InOrStdin()), destBackend)
+		if err != nil {
+			return err
+		}
+
+		records, err := keyring.MigrateBackend(clientCtx.Keyring, dest)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("%d keys have been successfully copied to the %s keyring\n", len(records), destBackend)
+		return nil
+	}
+
 	if _, err = clientCtx.Keyring.MigrateAll(); err != nil {
 		return err
 	}
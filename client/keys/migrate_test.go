@@ -18,6 +18,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type setter interface {
@@ -149,6 +150,54 @@ func (s *MigrateTestSuite) Test_runMigrateCmdLegacyMultiInfo() {
 	s.Require().NoError(cmd.ExecuteContext(ctx))
 }
 
+func (s *MigrateTestSuite) Test_runMigrateCmdDestinationBackend() {
+	multi := multisig.NewLegacyAminoPubKey(
+		1, []cryptotypes.PubKey{
+			s.pub,
+		},
+	)
+
+	legacyMultiInfo, err := keyring.NewLegacyMultiInfo("multi", multi)
+	s.Require().NoError(err)
+
+	item := design99keyring.Item{
+		Key:  "multi.info",
+		Data: keyring.MarshalInfo(legacyMultiInfo),
+	}
+
+	cmd := MigrateCommand()
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	kb, err := keyring.New(s.appName, keyring.BackendTest, s.T().TempDir(), mockIn, s.cdc)
+	s.Require().NoError(err)
+
+	setter, ok := kb.(setter)
+	s.Require().True(ok)
+	s.Require().NoError(setter.SetItem(item))
+
+	destDir := s.T().TempDir()
+	clientCtx := client.Context{}.WithKeyring(kb).WithCodec(s.cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=%s", flagDestKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=%s", flagDestKeyringDir, destDir),
+	})
+	s.Require().NoError(cmd.ExecuteContext(ctx))
+
+	dest, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, destDir, mockIn, s.cdc)
+	s.Require().NoError(err)
+
+	k, err := dest.Key("multi")
+	s.Require().NoError(err)
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	s.Require().True(pub.Equals(multi))
+
+	// copying again fails as the key now exists in the destination keyring
+	s.Require().ErrorIs(cmd.ExecuteContext(ctx), keyring.ErrKeyCollision)
+}
+
 func TestMigrateTestSuite(t *testing.T) {
 	suite.Run(t, new(MigrateTestSuite))
 }
@@ -10,4 +10,16 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrUnsupportedKeyringVersion is raised when the keyring was migrated by a
+	// newer binary to a format this binary does not support.
+	ErrUnsupportedKeyringVersion = errors.New("unsupported keyring version")
+
+	// ErrKeyCollision is raised when keys cannot be migrated into a keyring
+	// because keys with the same name or address already exist there.
+	ErrKeyCollision = errors.New("keys already exist in destination keyring")
+
+	// ErrUnsupportedKeyring is raised when an operation requires a keyring
+	// created by this package but is given another Keyring implementation.
+	ErrUnsupportedKeyring = errors.New("unsupported keyring implementation")
 )
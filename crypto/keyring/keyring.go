@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/99designs/keyring"
@@ -121,6 +122,10 @@ type Importer interface {
 
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+
+	// ImportItem imports the raw data of a keyring entry, e.g. taken from
+	// another keyring, migrating legacy amino entries to proto Records.
+	ImportItem(key string, data []byte) (*Record, error)
 }
 
 // Migrator is implemented by key stores and enables migration of  keys from amino to proto
@@ -156,6 +161,11 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// CheckVersion makes New fail with ErrUnsupportedKeyringVersion if the
+	// keyring was migrated by a newer binary. It is off by default since the
+	// check lists all keys of the backend; client.NewKeyringFromBackend
+	// enables it.
+	CheckVersion bool
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -197,7 +207,14 @@ func New(
 		return nil, err
 	}
 
-	return newKeystore(db, cdc, opts...), nil
+	ks := newKeystore(db, cdc, opts...)
+	if ks.options.CheckVersion {
+		if err := ks.checkVersion(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ks, nil
 }
 
 type keystore struct {
@@ -499,7 +516,7 @@ func (ks keystore) List() ([]*Record, error) {
 	var res []*Record //nolint:prealloc
 	sort.Strings(keys)
 	for _, key := range keys {
-		if strings.Contains(key, addressSuffix) {
+		if strings.Contains(key, addressSuffix) || isKeyringVersionKey(key) {
 			continue
 		}
 
@@ -839,6 +856,34 @@ func (ks keystore) existsInDb(addr sdk.Address, name string) (bool, error) {
 	return false, nil
 }
 
+// hasKey returns true if a key with the given name or address is stored in
+// the keyring. Unlike existsInDb it never modifies the keyring; an address
+// entry pointing to a missing key is not counted.
+func (ks keystore) hasKey(name string, addr sdk.Address) (bool, error) {
+	_, err := ks.db.Get(infoKey(name))
+	if err == nil {
+		return true, nil
+	} else if !errors.Is(err, keyring.ErrKeyNotFound) {
+		return false, err
+	}
+
+	addrItem, err := ks.db.Get(addrHexKeyAsString(addr))
+	if errors.Is(err, keyring.ErrKeyNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	_, err = ks.db.Get(string(addrItem.Data))
+	if errors.Is(err, keyring.ErrKeyNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (ks keystore) writeOfflineKey(name string, pk types.PubKey) (*Record, error) {
 	k, err := NewOfflineRecord(name, pk)
 	if err != nil {
@@ -870,7 +915,7 @@ func (ks keystore) MigrateAll() (bool, error) {
 
 	var migrated bool
 	for _, key := range keys {
-		if strings.Contains(key, addressSuffix) {
+		if strings.Contains(key, addressSuffix) || isKeyringVersionKey(key) {
 			continue
 		}
 
@@ -885,9 +930,248 @@ func (ks keystore) MigrateAll() (bool, error) {
 		}
 	}
 
+	// only mark keyrings that were actually migrated, so that merely reading
+	// an up to date keyring never writes to it
+	if !migrated {
+		return false, nil
+	}
+
+	if err := ks.writeVersion(keys); err != nil {
+		return migrated, err
+	}
+
 	return migrated, nil
 }
 
+// MigrateBackend copies all keys of the src keyring into the dst keyring,
+// e.g. from a test backend into a file backend. Legacy amino entries are
+// converted to proto Records on the way; src itself is left unmodified.
+// Nothing is written if any key cannot be read from src, or if a key with
+// the same name or address already exists in dst, in which case the error
+// lists the conflicting key names. Both keyrings must have been created by
+// New or NewInMemory, otherwise ErrUnsupportedKeyring is returned.
+func MigrateBackend(src, dst Keyring) ([]*Record, error) {
+	srcKs, ok := src.(keystore)
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedKeyring, "source keyring type %T", src)
+	}
+
+	dstKs, ok := dst.(keystore)
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedKeyring, "destination keyring type %T", dst)
+	}
+
+	keys, err := srcKs.db.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+
+	var records []*Record
+	for _, key := range keys {
+		if strings.Contains(key, addressSuffix) || isKeyringVersionKey(key) {
+			continue
+		}
+
+		k, err := srcKs.readRecord(key)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, k)
+	}
+
+	var conflicts []string
+	seenNames, seenAddrs := make(map[string]bool), make(map[string]bool)
+	for _, k := range records {
+		addr, err := k.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		// existsInDb may repair dst, which must stay untouched on conflicts
+		exists, err := dstKs.hasKey(k.Name, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		// keys of src sharing a name or address would also collide in dst
+		if exists || seenNames[k.Name] || seenAddrs[addr.String()] {
+			conflicts = append(conflicts, k.Name)
+		}
+
+		seenNames[k.Name] = true
+		seenAddrs[addr.String()] = true
+	}
+
+	if len(conflicts) > 0 {
+		return nil, errors.Wrapf(ErrKeyCollision, "%s", strings.Join(conflicts, ", "))
+	}
+
+	for _, k := range records {
+		if err := dstKs.writeRecord(k); err != nil {
+			return nil, err
+		}
+	}
+
+	dstKeys, err := dstKs.db.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dstKs.writeVersion(dstKeys); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// readRecord decodes the keyring entry stored under key into a Record,
+// converting legacy amino entries without writing them back.
+func (ks keystore) readRecord(key string) (*Record, error) {
+	item, err := ks.db.Get(key)
+	if err != nil {
+		return nil, wrapKeyNotFound(err, key)
+	}
+
+	k, _, err := ks.decodeItem(item)
+	return k, err
+}
+
+// decodeItem decodes the data of a keyring item into a Record. If the item
+// holds a legacy amino Info, it is converted and returned as well.
+func (ks keystore) decodeItem(item keyring.Item) (*Record, LegacyInfo, error) {
+	if len(item.Data) == 0 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, item.Key)
+	}
+
+	if k, err := ks.protoUnmarshalRecord(item.Data); err == nil {
+		return k, nil, nil
+	}
+
+	legacyInfo, err := unMarshalLegacyInfo(item.Data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to unmarshal item %s, err: %w", item.Key, err)
+	}
+
+	k, err := ks.convertFromLegacyInfo(legacyInfo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("convertFromLegacyInfo, err: %w", err)
+	}
+
+	return k, legacyInfo, nil
+}
+
+// isKeyringVersionKey reports whether key is a keyring version marker.
+func isKeyringVersionKey(key string) bool {
+	_, ok := parseKeyringVersionKey(key)
+	return ok
+}
+
+// keyringVersionKey returns the key of the marker for the given keyring
+// version.
+func keyringVersionKey(version int) string {
+	return fmt.Sprintf("%s%d.%s", keyringVersionPrefix, version, addressSuffix)
+}
+
+// parseKeyringVersionKey returns the version encoded in a keyring version
+// marker key. The version is kept in the key itself so that it can be read
+// without decrypting any item.
+func parseKeyringVersionKey(key string) (int, bool) {
+	suffix := "." + addressSuffix
+	if !strings.HasPrefix(key, keyringVersionPrefix) || !strings.HasSuffix(key, suffix) {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(key, keyringVersionPrefix), suffix))
+	if err != nil {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// checkVersion returns an error if the keyring was marked with a version newer
+// than the one supported by this binary.
+func (ks keystore) checkVersion() error {
+	keys, err := ks.db.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		version, ok := parseKeyringVersionKey(key)
+		if ok && version > keyringVersion {
+			return errors.Wrapf(ErrUnsupportedKeyringVersion, "keyring version %d is newer than the supported version %d", version, keyringVersion)
+		}
+	}
+
+	return nil
+}
+
+// writeVersion marks the keyring with the version of this binary, unless keys
+// already contains the marker.
+func (ks keystore) writeVersion(keys []string) error {
+	for _, key := range keys {
+		if version, ok := parseKeyringVersionKey(key); ok && version == keyringVersion {
+			return nil
+		}
+	}
+
+	return ks.SetItem(keyring.Item{
+		Key:         keyringVersionKey(keyringVersion),
+		Data:        []byte(strconv.Itoa(keyringVersion)),
+		Description: "SDK keyring version",
+	})
+}
+
+// ListLegacyKeys returns the keys of all entries of kr still stored as amino
+// encoded legacy Info, without migrating them. The keyring must have been
+// created by New or NewInMemory, otherwise ErrUnsupportedKeyring is returned.
+func ListLegacyKeys(kr Keyring) ([]string, error) {
+	ks, ok := kr.(keystore)
+	if !ok {
+		return nil, errors.Wrapf(ErrUnsupportedKeyring, "keyring type %T", kr)
+	}
+
+	return ks.listLegacyKeys()
+}
+
+func (ks keystore) listLegacyKeys() ([]string, error) {
+	keys, err := ks.db.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	var legacyKeys []string
+	sort.Strings(keys)
+	for _, key := range keys {
+		if strings.Contains(key, addressSuffix) || isKeyringVersionKey(key) {
+			continue
+		}
+
+		item, err := ks.db.Get(key)
+		if err != nil {
+			return nil, wrapKeyNotFound(err, key)
+		}
+
+		if len(item.Data) == 0 {
+			continue
+		}
+
+		if _, err := ks.protoUnmarshalRecord(item.Data); err == nil {
+			continue
+		}
+
+		if _, err := unMarshalLegacyInfo(item.Data); err == nil {
+			legacyKeys = append(legacyKeys, key)
+		}
+	}
+
+	return legacyKeys, nil
+}
+
 // migrate converts keyring.Item from amino to proto serialization format.
 func (ks keystore) migrate(key string) (*Record, bool, error) {
 	if !(strings.HasSuffix(key, infoSuffix)) && !(strings.HasPrefix(key, sdk.Bech32PrefixAccAddr)) {
@@ -924,19 +1208,53 @@ func (ks keystore) migrate(key string) (*Record, bool, error) {
 		return nil, false, fmt.Errorf("unable to serialize record, err: %w", err)
 	}
 
-	item = keyring.Item{
-		Key:         key,
-		Data:        serializedRecord,
-		Description: "SDK kerying version",
-	}
+	// keep the original item's description, label and keychain settings
+	legacyItem := item
+	item.Key = key
+	item.Data = serializedRecord
 	// 5.overwrite the keyring entry with
 	if err := ks.SetItem(item); err != nil {
 		return nil, false, fmt.Errorf("unable to set keyring.Item, err: %w", err)
 	}
 
+	// 6.re-read the entry and make sure it decodes to the same key, restoring
+	// the legacy entry otherwise
+	if err := ks.verifyMigratedItem(key, LegacyInfo.GetPubKey()); err != nil {
+		if restoreErr := ks.SetItem(legacyItem); restoreErr != nil {
+			return nil, false, fmt.Errorf("%s, unable to restore legacy keyring.Item, err: %w", err, restoreErr)
+		}
+
+		return nil, false, err
+	}
+
 	return k, true, nil
 }
 
+// verifyMigratedItem reads the keyring entry stored under key and checks that it
+// decodes to a Record holding the expected public key.
+func (ks keystore) verifyMigratedItem(key string, expected types.PubKey) error {
+	item, err := ks.db.Get(key)
+	if err != nil {
+		return fmt.Errorf("unable to read migrated keyring.Item, err: %w", err)
+	}
+
+	k, err := ks.protoUnmarshalRecord(item.Data)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal migrated record, err: %w", err)
+	}
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return fmt.Errorf("unable to get migrated record pubkey, err: %w", err)
+	}
+
+	if !pub.Equals(expected) {
+		return fmt.Errorf("migrated record pubkey %s does not match legacy info pubkey %s", pub, expected)
+	}
+
+	return nil
+}
+
 func (ks keystore) protoUnmarshalRecord(bz []byte) (*Record, error) {
 	k := new(Record)
 	if err := ks.cdc.Unmarshal(bz, k); err != nil {
@@ -950,6 +1268,56 @@ func (ks keystore) SetItem(item keyring.Item) error {
 	return ks.db.Set(item)
 }
 
+// ImportItem stores the key held by data, the raw data of the entry stored
+// under key in another keyring, and returns its Record. Data holding a legacy
+// amino Info is migrated to a proto Record before being written, so no
+// separate migration is needed; data already holding a Record is stored as
+// is. The key is stored under the name of the Record together with its
+// address index, like any other key; key itself is only used in errors.
+// ErrKeyCollision is returned if a key with the same name or address already
+// exists.
+func (ks keystore) ImportItem(key string, data []byte) (*Record, error) {
+	k, legacyInfo, err := ks.decodeItem(keyring.Item{Key: key, Data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := k.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := ks.hasKey(k.Name, addr)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.Wrapf(ErrKeyCollision, "%s", k.Name)
+	}
+
+	if err := ks.writeRecord(k); err != nil {
+		return nil, err
+	}
+
+	if legacyInfo == nil {
+		return k, nil
+	}
+
+	// re-read the entry and make sure it decodes to the same key, removing
+	// it again otherwise
+	if err := ks.verifyMigratedItem(infoKey(k.Name), legacyInfo.GetPubKey()); err != nil {
+		for _, key := range []string{addrHexKeyAsString(addr), infoKey(k.Name)} {
+			if removeErr := ks.db.Remove(key); removeErr != nil {
+				return nil, fmt.Errorf("%s, unable to remove imported keyring.Item, err: %w", err, removeErr)
+			}
+		}
+
+		return nil, err
+	}
+
+	return k, nil
+}
+
 func (ks keystore) convertFromLegacyInfo(info LegacyInfo) (*Record, error) {
 	if info == nil {
 		return nil, errors.New("unable to convert LegacyInfo to Record cause info is nil")
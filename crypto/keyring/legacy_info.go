@@ -177,12 +177,21 @@ type LegacyMultiInfo struct {
 
 // NewLegacyMultiInfo creates a new legacyMultiInfo instance
 func NewLegacyMultiInfo(name string, pub cryptotypes.PubKey) (LegacyInfo, error) {
-	if _, ok := pub.(*multisig.LegacyAminoPubKey); !ok {
+	multiPK, ok := pub.(*multisig.LegacyAminoPubKey)
+	if !ok {
 		return nil, fmt.Errorf("MultiInfo supports only multisig.LegacyAminoPubKey, got  %T", pub)
 	}
+
+	pubKeys := make([]multisigPubKeyInfo, len(multiPK.PubKeys))
+	for i, pk := range multiPK.GetPubKeys() {
+		pubKeys[i] = multisigPubKeyInfo{PubKey: pk, Weight: 1}
+	}
+
 	return &LegacyMultiInfo{
-		Name:   name,
-		PubKey: pub,
+		Name:      name,
+		PubKey:    pub,
+		Threshold: uint(multiPK.Threshold),
+		PubKeys:   pubKeys,
 	}, nil
 }
 
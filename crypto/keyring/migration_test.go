@@ -1,6 +1,8 @@
 package keyring
 
 import (
+	"bytes"
+	"sort"
 	"strings"
 	"testing"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -122,6 +125,148 @@ func (s *MigrationTestSuite) TestMigrateLegacyMultiKey() {
 	s.Require().NoError(err)
 }
 
+func (s *MigrationTestSuite) TestMigrateLegacyMultiKeyThreshold() {
+	testCases := []struct {
+		name       string
+		threshold  int
+		numKeys    int
+		migrateAll bool
+	}{
+		{"2-of-3 through migrate", 2, 3, false},
+		{"3-of-5 through MigrateAll", 3, 5, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			kb, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+			s.Require().NoError(err)
+			ks, ok := kb.(keystore)
+			s.Require().True(ok)
+
+			pubKeys := make([]cryptotypes.PubKey, tc.numKeys)
+			for i := range pubKeys {
+				pubKeys[i] = secp256k1.GenPrivKey().PubKey()
+			}
+			// keep the keys out of address order, the migration must not sort them
+			sort.Slice(pubKeys, func(i, j int) bool {
+				return bytes.Compare(pubKeys[i].Address(), pubKeys[j].Address()) > 0
+			})
+			multi := multisig.NewLegacyAminoPubKey(tc.threshold, pubKeys)
+
+			legacyMultiInfo, err := NewLegacyMultiInfo("multi", multi)
+			s.Require().NoError(err)
+			s.Require().NoError(ks.SetItem(keyring.Item{
+				Key:  infoKey("multi"),
+				Data: MarshalInfo(legacyMultiInfo),
+			}))
+
+			var migrated bool
+			if tc.migrateAll {
+				migrated, err = kb.MigrateAll()
+			} else {
+				_, migrated, err = ks.migrate("multi")
+			}
+			s.Require().NoError(err)
+			s.Require().True(migrated)
+
+			k, err := kb.Key("multi")
+			s.Require().NoError(err)
+			s.Require().NotNil(k.GetMulti())
+
+			pub, err := k.GetPubKey()
+			s.Require().NoError(err)
+			s.Require().True(pub.Equals(multi))
+
+			migratedMulti, ok := pub.(*multisig.LegacyAminoPubKey)
+			s.Require().True(ok)
+			s.Require().Equal(uint32(tc.threshold), migratedMulti.Threshold)
+			s.Require().Len(migratedMulti.GetPubKeys(), len(pubKeys))
+			for i, pk := range migratedMulti.GetPubKeys() {
+				s.Require().True(pk.Equals(pubKeys[i]))
+			}
+		})
+	}
+}
+
+func (s *MigrationTestSuite) TestMigrateVerifiesWrittenRecord() {
+	legacyOfflineInfo := newLegacyOfflineInfo(n1, s.pub, hd.Secp256k1.Name())
+	serializedLegacyOfflineInfo := MarshalInfo(legacyOfflineInfo)
+
+	item := keyring.Item{
+		Key:         n1,
+		Data:        serializedLegacyOfflineInfo,
+		Description: "SDK kerying version",
+	}
+
+	s.Require().NoError(s.ks.SetItem(item))
+
+	_, migrated, err := s.ks.migrate(n1)
+	s.Require().True(migrated)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.ks.verifyMigratedItem(n1, s.pub))
+
+	otherPub := secp256k1.GenPrivKey().PubKey()
+	err = s.ks.verifyMigratedItem(n1, otherPub)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "does not match legacy info pubkey")
+}
+
+func (s *MigrationTestSuite) TestMigrateRestoresLegacyItemOnVerificationFailure() {
+	// reading the migrated entry back yields a record holding another pubkey
+	otherRecord, err := NewOfflineRecord("legacy", secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+	db := &corruptReadBackDB{
+		Keyring: keyring.NewArrayKeyring(nil),
+		key:     infoKey("legacy"),
+		data:    getCodec().MustMarshal(otherRecord),
+	}
+	ks := newKeystore(db, getCodec())
+
+	legacyOfflineInfo := newLegacyOfflineInfo("legacy", s.pub, hd.Secp256k1.Name())
+	legacyItem := keyring.Item{
+		Key:         infoKey("legacy"),
+		Data:        MarshalInfo(legacyOfflineInfo),
+		Description: "SDK kerying version",
+	}
+	s.Require().NoError(db.Keyring.Set(legacyItem))
+
+	_, migrated, err := ks.migrate(infoKey("legacy"))
+	s.Require().False(migrated)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "does not match legacy info pubkey")
+
+	// the legacy entry is back in place, unchanged
+	item, err := db.Keyring.Get(infoKey("legacy"))
+	s.Require().NoError(err)
+	s.Require().Equal(legacyItem, item)
+}
+
+func (s *MigrationTestSuite) TestMigratePreservesItemMetadata() {
+	legacyOfflineInfo := newLegacyOfflineInfo(n1, s.pub, hd.Secp256k1.Name())
+	serializedLegacyOfflineInfo := MarshalInfo(legacyOfflineInfo)
+
+	item := keyring.Item{
+		Key:         n1,
+		Data:        serializedLegacyOfflineInfo,
+		Label:       "custom label",
+		Description: "custom description",
+	}
+
+	s.Require().NoError(s.ks.SetItem(item))
+
+	_, migrated, err := s.ks.migrate(n1)
+	s.Require().True(migrated)
+	s.Require().NoError(err)
+
+	migratedItem, err := s.ks.db.Get(n1)
+	s.Require().NoError(err)
+	s.Require().Equal(item.Label, migratedItem.Label)
+	s.Require().Equal(item.Description, migratedItem.Description)
+	s.Require().NotEqual(item.Data, migratedItem.Data)
+}
+
 func (s *MigrationTestSuite) TestMigrateLocalRecord() {
 	k1, err := NewLocalRecord("test record", s.priv, s.pub)
 	s.Require().NoError(err)
@@ -202,6 +347,277 @@ func (s *MigrationTestSuite) TestMigrateAllLegacyMultiOffline() {
 	s.Require().NoError(err)
 }
 
+func (s *MigrationTestSuite) TestListLegacyKeys() {
+	kb, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+	ks, ok := kb.(keystore)
+	s.Require().True(ok)
+
+	_, err = kb.SaveOfflineKey("proto", secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+
+	multi := multisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{s.pub})
+	legacyMultiInfo, err := NewLegacyMultiInfo("multi", multi)
+	s.Require().NoError(err)
+
+	items := []keyring.Item{
+		{Key: infoKey("offline"), Data: MarshalInfo(newLegacyOfflineInfo("offline", s.pub, hd.Secp256k1.Name()))},
+		{Key: infoKey("multi"), Data: MarshalInfo(legacyMultiInfo)},
+		{Key: infoKey("random"), Data: []byte("abckd0s03l")},
+	}
+	for _, item := range items {
+		s.Require().NoError(ks.SetItem(item))
+	}
+
+	legacyKeys, err := ListLegacyKeys(ks)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{infoKey("multi"), infoKey("offline")}, legacyKeys)
+
+	migrated, err := ks.MigrateAll()
+	s.Require().True(migrated)
+	s.Require().NoError(err)
+
+	legacyKeys, err = ListLegacyKeys(ks)
+	s.Require().NoError(err)
+	s.Require().Empty(legacyKeys)
+
+	_, err = ListLegacyKeys(struct{ Keyring }{kb})
+	s.Require().ErrorIs(err, ErrUnsupportedKeyring)
+}
+
+func (s *MigrationTestSuite) TestKeyringVersionMarker() {
+	dir := s.T().TempDir()
+	checkVersion := func(options *Options) { options.CheckVersion = true }
+
+	kb, err := New(n1, BackendTest, dir, strings.NewReader(""), getCodec(), checkVersion)
+	s.Require().NoError(err)
+	ks, ok := kb.(keystore)
+	s.Require().True(ok)
+
+	_, err = kb.SaveOfflineKey("offline", s.pub)
+	s.Require().NoError(err)
+
+	// listing a keyring with nothing to migrate does not write to it
+	records, err := kb.List()
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+
+	keys, err := ks.db.Keys()
+	s.Require().NoError(err)
+	s.Require().NotContains(keys, keyringVersionKey(1))
+
+	// migrating a legacy entry marks the keyring with the current version
+	legacyLocalInfo := newLegacyLocalInfo("legacy", s.pub, string(legacy.Cdc.MustMarshal(s.priv)), hd.Secp256k1.Name())
+	s.Require().NoError(ks.SetItem(keyring.Item{
+		Key:  infoKey("legacy"),
+		Data: MarshalInfo(legacyLocalInfo),
+	}))
+
+	migrated, err := kb.MigrateAll()
+	s.Require().NoError(err)
+	s.Require().True(migrated)
+
+	keys, err = ks.db.Keys()
+	s.Require().NoError(err)
+	s.Require().Contains(keys, keyringVersionKey(1))
+	// binaries unaware of the marker skip it like an address index item
+	s.Require().Contains(keyringVersionKey(1), addressSuffix)
+
+	records, err = kb.List()
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+
+	_, err = New(n1, BackendTest, dir, strings.NewReader(""), getCodec(), checkVersion)
+	s.Require().NoError(err)
+
+	// simulate a keyring migrated by a newer binary
+	s.Require().NoError(ks.SetItem(keyring.Item{Key: keyringVersionKey(2), Data: []byte("2")}))
+
+	_, err = New(n1, BackendTest, dir, strings.NewReader(""), getCodec(), checkVersion)
+	s.Require().ErrorIs(err, ErrUnsupportedKeyringVersion)
+
+	// the check is opt-in
+	_, err = New(n1, BackendTest, dir, strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+}
+
+func (s *MigrationTestSuite) TestMigrateBackend() {
+	src, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+	srcKs, ok := src.(keystore)
+	s.Require().True(ok)
+
+	dst, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+
+	// one legacy amino entry and one already migrated record
+	legacyLocalInfo := newLegacyLocalInfo("legacy", s.pub, string(legacy.Cdc.MustMarshal(s.priv)), hd.Secp256k1.Name())
+	s.Require().NoError(srcKs.SetItem(keyring.Item{
+		Key:  infoKey("legacy"),
+		Data: MarshalInfo(legacyLocalInfo),
+	}))
+
+	offlinePub := secp256k1.GenPrivKey().PubKey()
+	_, err = src.SaveOfflineKey("offline", offlinePub)
+	s.Require().NoError(err)
+
+	records, err := MigrateBackend(src, dst)
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+
+	k, err := dst.Key("legacy")
+	s.Require().NoError(err)
+	s.Require().NotNil(k.GetLocal())
+	pub, err := k.GetPubKey()
+	s.Require().NoError(err)
+	s.Require().True(pub.Equals(s.pub))
+
+	k, err = dst.Key("offline")
+	s.Require().NoError(err)
+	pub, err = k.GetPubKey()
+	s.Require().NoError(err)
+	s.Require().True(pub.Equals(offlinePub))
+
+	// the source keyring is left as is
+	legacyKeys, err := ListLegacyKeys(src)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{infoKey("legacy")}, legacyKeys)
+
+	// migrating again collides with the keys now present in dst
+	_, err = MigrateBackend(src, dst)
+	s.Require().ErrorIs(err, ErrKeyCollision)
+	s.Require().Contains(err.Error(), "legacy, offline")
+
+	dstRecords, err := dst.List()
+	s.Require().NoError(err)
+	s.Require().Len(dstRecords, 2)
+}
+
+func (s *MigrationTestSuite) TestMigrateBackendConflictLeavesDestinationUntouched() {
+	src, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+	dst, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+	dstKs, ok := dst.(keystore)
+	s.Require().True(ok)
+
+	_, err = src.SaveOfflineKey("offline", s.pub)
+	s.Require().NoError(err)
+	_, err = src.SaveOfflineKey("other", secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+
+	// dst holds a dangling address entry for the first key, and a key with
+	// the same name as the second one
+	danglingAddrKey := addrHexKeyAsString(sdk.AccAddress(s.pub.Address()))
+	s.Require().NoError(dstKs.SetItem(keyring.Item{Key: danglingAddrKey, Data: []byte(infoKey("offline"))}))
+	_, err = dst.SaveOfflineKey("other", secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+
+	keysBefore, err := dstKs.db.Keys()
+	s.Require().NoError(err)
+
+	_, err = MigrateBackend(src, dst)
+	s.Require().ErrorIs(err, ErrKeyCollision)
+	s.Require().Contains(err.Error(), "other")
+	s.Require().NotContains(err.Error(), "offline")
+
+	keysAfter, err := dstKs.db.Keys()
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(keysBefore, keysAfter)
+	s.Require().Contains(keysAfter, danglingAddrKey)
+}
+
+func (s *MigrationTestSuite) TestMigrateBackendUnsupportedKeyring() {
+	kb, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+
+	other := struct{ Keyring }{kb}
+
+	_, err = MigrateBackend(other, kb)
+	s.Require().ErrorIs(err, ErrUnsupportedKeyring)
+
+	_, err = MigrateBackend(kb, other)
+	s.Require().ErrorIs(err, ErrUnsupportedKeyring)
+}
+
+func (s *MigrationTestSuite) TestImportItem() {
+	kb, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+	ks, ok := kb.(keystore)
+	s.Require().True(ok)
+
+	legacyLocalInfo := newLegacyLocalInfo("legacy", s.pub, string(legacy.Cdc.MustMarshal(s.priv)), hd.Secp256k1.Name())
+	legacyData := MarshalInfo(legacyLocalInfo)
+
+	k, err := ks.ImportItem(infoKey("legacy"), legacyData)
+	s.Require().NoError(err)
+	s.Require().Equal("legacy", k.Name)
+	s.Require().NotNil(k.GetLocal())
+
+	// the stored item holds a proto record and nothing is left to migrate
+	storedItem, err := ks.db.Get(infoKey("legacy"))
+	s.Require().NoError(err)
+	stored, err := ks.protoUnmarshalRecord(storedItem.Data)
+	s.Require().NoError(err)
+	s.Require().Equal(k, stored)
+
+	legacyKeys, err := ListLegacyKeys(kb)
+	s.Require().NoError(err)
+	s.Require().Empty(legacyKeys)
+
+	// the key can be looked up by address as well
+	k2, err := kb.KeyByAddress(sdk.AccAddress(s.pub.Address()))
+	s.Require().NoError(err)
+	s.Require().Equal(k, k2)
+
+	// an existing key is never overwritten, whether by name or by address
+	_, err = ks.ImportItem(infoKey("legacy"), legacyData)
+	s.Require().ErrorIs(err, ErrKeyCollision)
+
+	renamedInfo := newLegacyOfflineInfo("renamed", s.pub, hd.Secp256k1.Name())
+	_, err = ks.ImportItem(infoKey("renamed"), MarshalInfo(renamedInfo))
+	s.Require().ErrorIs(err, ErrKeyCollision)
+
+	// importing an item already holding a record stores it unchanged
+	kb2, err := New(n1, BackendTest, s.T().TempDir(), strings.NewReader(""), getCodec())
+	s.Require().NoError(err)
+	ks2, ok := kb2.(keystore)
+	s.Require().True(ok)
+
+	k3, err := ks2.ImportItem(storedItem.Key, storedItem.Data)
+	s.Require().NoError(err)
+	s.Require().Equal(k, k3)
+
+	importedItem, err := ks2.db.Get(infoKey("legacy"))
+	s.Require().NoError(err)
+	s.Require().Equal(storedItem.Data, importedItem.Data)
+
+	_, err = ks.ImportItem(infoKey("empty"), nil)
+	s.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+}
+
+func (s *MigrationTestSuite) TestImportItemVerificationFailure() {
+	// reading the imported key back yields a record holding another pubkey
+	otherRecord, err := NewOfflineRecord("legacy", secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+	db := &corruptReadBackDB{
+		Keyring: keyring.NewArrayKeyring(nil),
+		key:     infoKey("legacy"),
+		data:    getCodec().MustMarshal(otherRecord),
+	}
+	ks := newKeystore(db, getCodec())
+
+	legacyLocalInfo := newLegacyLocalInfo("legacy", s.pub, string(legacy.Cdc.MustMarshal(s.priv)), hd.Secp256k1.Name())
+	_, err = ks.ImportItem(infoKey("legacy"), MarshalInfo(legacyLocalInfo))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "does not match legacy info pubkey")
+
+	// nothing is left behind
+	keys, err := db.Keyring.Keys()
+	s.Require().NoError(err)
+	s.Require().Empty(keys)
+}
+
 func (s *MigrationTestSuite) TestMigrateAllNoItem() {
 	migrated, err := s.kb.MigrateAll()
 	s.Require().False(migrated)
@@ -271,3 +687,31 @@ func newLegacyOfflineInfo(name string, pub cryptotypes.PubKey, algo hd.PubKeyTyp
 		Algo:   algo,
 	}
 }
+
+// corruptReadBackDB is a keyring.Keyring whose item stored under key reads
+// back as data once it has been written through it, simulating a write that
+// did not persist as expected.
+type corruptReadBackDB struct {
+	keyring.Keyring
+
+	key     string
+	data    []byte
+	written bool
+}
+
+func (db *corruptReadBackDB) Set(item keyring.Item) error {
+	if item.Key == db.key {
+		db.written = true
+	}
+
+	return db.Keyring.Set(item)
+}
+
+func (db *corruptReadBackDB) Get(key string) (keyring.Item, error) {
+	item, err := db.Keyring.Get(key)
+	if err == nil && db.written && key == db.key {
+		item.Data = db.data
+	}
+
+	return item, err
+}
@@ -38,6 +38,14 @@ const (
 	defaultEntropySize = 256
 	addressSuffix      = "address"
 	infoSuffix         = "info"
+
+	// keyringVersionPrefix prefixes the backend item marking the keyring format
+	// version, e.g. "keyring_version.1.address". The marker ends with the
+	// address suffix so that binaries unaware of it skip it when listing keys,
+	// as they do for address index items.
+	keyringVersionPrefix = "keyring_version."
+	// keyringVersion is the keyring format version written by this binary.
+	keyringVersion = 1
 )
 
 // KeyType reflects a human-readable type for key listing.
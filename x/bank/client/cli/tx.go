@@ -20,7 +20,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewSendTxCmd())
+	txCmd.AddCommand(
+		NewSendTxCmd(),
+		NewBurnTxCmd(),
+	)
 
 	return txCmd
 }
@@ -58,3 +61,33 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "burn [from_key_or_address] [amount]",
+		Short: `Burn funds of an account, removing them from the total supply. Note, the '--from'
+flag is ignored as it is implied from [from_key_or_address].`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,6 +18,13 @@ func MsgSendExec(clientCtx client.Context, from, to, amount fmt.Stringer, extraA
 	return clitestutil.ExecTestCLICmd(clientCtx, bankcli.NewSendTxCmd(), args)
 }
 
+func MsgBurnExec(clientCtx client.Context, from, amount fmt.Stringer, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{from.String(), amount.String()}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, bankcli.NewBurnTxCmd(), args)
+}
+
 func QueryBalancesExec(clientCtx client.Context, address fmt.Stringer, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{address.String(), fmt.Sprintf("--%s=json", cli.OutputFlag)}
 	args = append(args, extraArgs...)
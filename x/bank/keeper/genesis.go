@@ -15,12 +15,28 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	totalSupply := sdk.Coins{}
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
 
+	// initBalances would silently overwrite duplicate entries, so reject them
+	seenBalances := make(map[string]bool)
+
 	for _, balance := range genState.Balances {
 		addr, err := sdk.AccAddressFromBech32(balance.Address)
 		if err != nil {
 			panic(err)
 		}
 
+		if seenBalances[string(addr)] {
+			panic(fmt.Errorf("duplicate balance for address %s in genesis", balance.Address))
+		}
+		seenBalances[string(addr)] = true
+
+		seenDenoms := make(map[string]bool)
+		for _, coin := range balance.Coins {
+			if seenDenoms[coin.Denom] {
+				panic(fmt.Errorf("duplicate denom %s in genesis balance for address %s", coin.Denom, balance.Address))
+			}
+			seenDenoms[coin.Denom] = true
+		}
+
 		if err := k.initBalances(ctx, addr, balance.Coins); err != nil {
 			panic(fmt.Errorf("error on setting balances %w", err))
 		}
@@ -39,6 +55,12 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, supplyCap := range genState.SupplyCaps {
+		if err := k.SetSupplyCap(ctx, supplyCap); err != nil {
+			panic(fmt.Errorf("error on setting supply cap %w", err))
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -48,10 +70,13 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
+	genState.SupplyCaps = k.GetAllSupplyCaps(ctx)
+
+	return genState
 }
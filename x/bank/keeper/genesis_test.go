@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -70,6 +73,69 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *IntegrationTestSuite) TestGenesisSupplyCaps() {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	supplyCaps := sdk.NewCoins(sdk.NewInt64Coin("barcap", 10), sdk.NewInt64Coin("foocap", 100))
+
+	g := types.DefaultGenesisState()
+	g.Balances = []types.Balance{{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("foocap", 60))}}
+	g.SupplyCaps = supplyCaps
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(suite.ctx, g)
+
+	supplyCap, found := bk.GetSupplyCap(suite.ctx, "foocap")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt64Coin("foocap", 100), supplyCap)
+
+	// the caps survive an export and import
+	exportGenesis := bk.ExportGenesis(suite.ctx)
+	suite.Require().Equal(supplyCaps, exportGenesis.SupplyCaps)
+	suite.Require().NoError(exportGenesis.Validate())
+
+	suite.SetupTest()
+	suite.app.BankKeeper.InitGenesis(suite.ctx, exportGenesis)
+	suite.Require().Equal(supplyCaps, suite.app.BankKeeper.ExportGenesis(suite.ctx).SupplyCaps)
+
+	// a cap below the genesis supply is rejected
+	g.SupplyCaps = sdk.NewCoins(sdk.NewInt64Coin("foocap", 50))
+	suite.SetupTest()
+	suite.Require().Panics(func() { suite.app.BankKeeper.InitGenesis(suite.ctx, g) })
+}
+
+func (suite *IntegrationTestSuite) TestInitGenesisDuplicateBalances() {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	fooCoins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	barCoins := sdk.NewCoins(sdk.NewInt64Coin("bar", 10))
+
+	g := types.DefaultGenesisState()
+	g.Balances = []types.Balance{
+		{Address: addr.String(), Coins: fooCoins},
+		{Address: addr.String(), Coins: barCoins},
+	}
+	suite.Require().PanicsWithError(
+		fmt.Sprintf("duplicate balance for address %s in genesis", addr),
+		func() { suite.app.BankKeeper.InitGenesis(suite.ctx, g) },
+	)
+
+	// the same address encoded in upper case is a duplicate as well
+	g = types.DefaultGenesisState()
+	g.Balances = []types.Balance{
+		{Address: addr.String(), Coins: fooCoins},
+		{Address: strings.ToUpper(addr.String()), Coins: barCoins},
+	}
+	suite.Require().Panics(func() { suite.app.BankKeeper.InitGenesis(suite.ctx, g) })
+	suite.Require().Error(g.Validate())
+
+	g = types.DefaultGenesisState()
+	g.Balances = []types.Balance{
+		{Address: addr.String(), Coins: sdk.Coins{fooCoins[0], fooCoins[0]}},
+	}
+	suite.Require().PanicsWithError(
+		fmt.Sprintf("duplicate denom foo in genesis balance for address %s", addr),
+		func() { suite.app.BankKeeper.InitGenesis(suite.ctx, g) },
+	)
+}
+
 func (suite *IntegrationTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
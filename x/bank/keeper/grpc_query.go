@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -75,6 +77,37 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// BalancesByDenoms implements the Query/BalancesByDenoms gRPC method
+func (k BaseKeeper) BalancesByDenoms(ctx context.Context, req *types.QueryBalancesByDenomsRequest) (*types.QueryBalancesByDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if len(req.Denoms) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "denoms cannot be empty")
+	}
+
+	for _, denom := range req.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	balances := k.GetBalances(sdkCtx, addr, req.Denoms)
+
+	return &types.QueryBalancesByDenomsResponse{Balances: balances}, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
 func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -102,6 +135,67 @@ func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// SupplyByDenoms implements the Query/SupplyByDenoms gRPC method
+func (k BaseKeeper) SupplyByDenoms(c context.Context, req *types.QuerySupplyByDenomsRequest) (*types.QuerySupplyByDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Denoms) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "denoms cannot be empty")
+	}
+
+	for _, denom := range req.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	supply := k.GetSupplyOf(ctx, req.Denoms)
+
+	return &types.QuerySupplyByDenomsResponse{Supply: supply}, nil
+}
+
+// CirculatingSupply implements the Query/CirculatingSupply gRPC method
+func (k BaseKeeper) CirculatingSupply(c context.Context, req *types.QueryCirculatingSupplyRequest) (*types.QueryCirculatingSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	excluded := make(map[string]sdk.AccAddress, len(req.ExcludedModuleAccounts))
+	for _, name := range req.ExcludedModuleAccounts {
+		addr := k.ak.GetModuleAddress(name)
+		if addr == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown module account %s", name)
+		}
+
+		excluded[name] = addr
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	totalSupply, pageRes, err := k.GetPaginatedTotalSupply(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	circulating := make(sdk.Coins, 0, len(totalSupply))
+	for _, supply := range totalSupply {
+		amount := supply.Amount
+		for _, addr := range excluded {
+			amount = amount.Sub(k.GetBalance(ctx, addr, supply.Denom).Amount)
+		}
+
+		if amount.IsNegative() {
+			return nil, status.Errorf(codes.Internal, "module account balances exceed total supply of %s", supply.Denom)
+		}
+
+		circulating = append(circulating, sdk.NewCoin(supply.Denom, amount))
+	}
+
+	return &types.QueryCirculatingSupplyResponse{Supply: circulating, Pagination: pageRes}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -210,3 +304,140 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// SendEnabled implements the Query/SendEnabled gRPC method
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries := k.GetParams(ctx).SendEnabled
+
+	if len(req.Denoms) > 0 {
+		denoms := make(map[string]bool, len(req.Denoms))
+		for _, denom := range req.Denoms {
+			denoms[denom] = true
+		}
+
+		filtered := make([]*types.SendEnabled, 0, len(req.Denoms))
+		for _, entry := range entries {
+			if denoms[entry.Denom] {
+				filtered = append(filtered, entry)
+			}
+		}
+
+		entries = filtered
+	}
+
+	sendEnabled, pageRes, err := paginateSendEnabled(entries, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QuerySendEnabledResponse{SendEnabled: sendEnabled, Pagination: pageRes}, nil
+}
+
+// paginateSendEnabled paginates over the given entries ordered by denom. It
+// follows the semantics of query.Paginate, using the denom as the entry key.
+func paginateSendEnabled(entries []*types.SendEnabled, pageReq *query.PageRequest) ([]*types.SendEnabled, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	sorted := make([]*types.SendEnabled, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if pageReq.Reverse {
+			return sorted[i].Denom > sorted[j].Denom
+		}
+		return sorted[i].Denom < sorted[j].Denom
+	})
+
+	var start int
+	if len(pageReq.Key) != 0 {
+		key := string(pageReq.Key)
+		start = sort.Search(len(sorted), func(i int) bool {
+			if pageReq.Reverse {
+				return sorted[i].Denom <= key
+			}
+			return sorted[i].Denom >= key
+		})
+	} else if pageReq.Offset < uint64(len(sorted)) {
+		start = int(pageReq.Offset)
+	} else {
+		start = len(sorted)
+	}
+
+	end := len(sorted)
+	if limit < uint64(end-start) {
+		end = start + int(limit)
+	}
+
+	res := &query.PageResponse{}
+	if end < len(sorted) {
+		res.NextKey = []byte(sorted[end].Denom)
+	}
+
+	if len(pageReq.Key) == 0 && countTotal {
+		res.Total = uint64(len(sorted))
+	}
+
+	return sorted[start:end], res, nil
+}
+
+// TransferAllowed implements the Query/TransferAllowed gRPC method
+func (k BaseKeeper) TransferAllowed(ctx context.Context, req *types.QueryTransferAllowedRequest) (*types.QueryTransferAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FromAddress == "" || req.ToAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if !req.Amount.IsValid() || !req.Amount.IsAllPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %s", req.Amount)
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from address: %s", err.Error())
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	reason := types.TransferDeniedReasonNone
+	switch {
+	case k.IsSendEnabledCoins(sdkCtx, req.Amount...) != nil:
+		reason = types.TransferDeniedReasonSendDisabled
+	case k.BlockedAddr(toAddr):
+		reason = types.TransferDeniedReasonBlockedAddress
+	case k.checkRecipientAccount(sdkCtx, toAddr) != nil:
+		reason = types.TransferDeniedReasonRecipientAccountMissing
+	case !k.SpendableCoins(sdkCtx, fromAddr).IsAllGTE(req.Amount):
+		reason = types.TransferDeniedReasonInsufficientFunds
+	}
+
+	return &types.QueryTransferAllowedResponse{
+		Allowed: reason == types.TransferDeniedReasonNone,
+		Reason:  reason,
+	}, nil
+}
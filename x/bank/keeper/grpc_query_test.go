@@ -4,8 +4,12 @@ import (
 	gocontext "context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
@@ -86,6 +90,144 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestQueryBalancesByDenoms() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	_, err := queryClient.BalancesByDenoms(gocontext.Background(), &types.QueryBalancesByDenomsRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.BalancesByDenoms(gocontext.Background(), &types.QueryBalancesByDenomsRequest{Address: addr.String()})
+	suite.Require().Error(err)
+
+	_, err = queryClient.BalancesByDenoms(gocontext.Background(), types.NewQueryBalancesByDenomsRequest(addr, []string{fooDenom, ""}))
+	suite.Require().Error(err)
+
+	origCoins := sdk.NewCoins(newFooCoin(50), newBarCoin(30), sdk.NewInt64Coin("baz", 10))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, acc.GetAddress(), origCoins))
+
+	req := types.NewQueryBalancesByDenomsRequest(addr, []string{fooDenom, barDenom, "absent"})
+	res, err := queryClient.BalancesByDenoms(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	expected := sdk.Coins{sdk.NewInt64Coin("absent", 0), newBarCoin(30), newFooCoin(50)}
+	suite.Require().Equal(expected, res.Balances)
+	suite.Require().Equal(expected, app.BankKeeper.GetBalances(ctx, addr, []string{fooDenom, barDenom, "absent", fooDenom}))
+}
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params := types.DefaultParams().
+		SetSendEnabledParam("denomd", true).
+		SetSendEnabledParam("denoma", false).
+		SetSendEnabledParam("denomc", true).
+		SetSendEnabledParam("denomb", false)
+	app.BankKeeper.SetParams(ctx, params)
+
+	res, err := queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.SendEnabled, 4)
+	suite.Require().Equal(uint64(4), res.Pagination.Total)
+	suite.Require().Equal("denoma", res.SendEnabled[0].Denom)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{
+		types.NewSendEnabled("denoma", false),
+		types.NewSendEnabled("denomb", false),
+		types.NewSendEnabled("denomc", true),
+	}, res.SendEnabled)
+	suite.Require().Equal([]byte("denomd"), res.Pagination.NextKey)
+	suite.Require().Equal(uint64(4), res.Pagination.Total)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled("denomd", true)}, res.SendEnabled)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Denoms: []string{"denomd", "denomb", "unset"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{
+		types.NewSendEnabled("denomb", false),
+		types.NewSendEnabled("denomd", true),
+	}, res.SendEnabled)
+
+	_, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{
+		Pagination: &query.PageRequest{Key: []byte("denomb"), Offset: 1},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestQueryTransferAllowed() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	_, _, fromAddr := testdata.KeyTestPubAddr()
+	_, _, toAddr := testdata.KeyTestPubAddr()
+	amount := sdk.NewCoins(newFooCoin(50))
+
+	_, err := queryClient.TransferAllowed(gocontext.Background(), &types.QueryTransferAllowedRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.TransferAllowed(gocontext.Background(), &types.QueryTransferAllowedRequest{FromAddress: fromAddr.String(), ToAddress: toAddr.String()})
+	suite.Require().Error(err)
+
+	_, err = queryClient.TransferAllowed(gocontext.Background(), &types.QueryTransferAllowedRequest{FromAddress: "invalid", ToAddress: toAddr.String(), Amount: amount})
+	suite.Require().Error(err)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, fromAddr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, fromAddr, sdk.NewCoins(newFooCoin(100), newBarCoin(10))))
+
+	res, err := queryClient.TransferAllowed(gocontext.Background(), types.NewQueryTransferAllowedRequest(fromAddr, toAddr, amount))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Allowed)
+	suite.Require().Equal(types.TransferDeniedReasonNone, res.Reason)
+
+	res, err = queryClient.TransferAllowed(gocontext.Background(), types.NewQueryTransferAllowedRequest(fromAddr, toAddr, sdk.NewCoins(newFooCoin(50), newBarCoin(20))))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Allowed)
+	suite.Require().Equal(types.TransferDeniedReasonInsufficientFunds, res.Reason)
+
+	blockedAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blockedAddr))
+	res, err = queryClient.TransferAllowed(gocontext.Background(), types.NewQueryTransferAllowedRequest(fromAddr, blockedAddr, amount))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Allowed)
+	suite.Require().Equal(types.TransferDeniedReasonBlockedAddress, res.Reason)
+
+	// toAddr has no account, which is only rejected if recipient account
+	// creation is disabled
+	suite.Require().False(app.AccountKeeper.HasAccount(ctx, toAddr))
+	bk := app.BankKeeper.WithRecipientAccountCreationDisabled()
+	req := types.NewQueryTransferAllowedRequest(fromAddr, toAddr, amount)
+	res, err = bk.TransferAllowed(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Allowed)
+	suite.Require().Equal(types.TransferDeniedReasonRecipientAccountMissing, res.Reason)
+	suite.Require().ErrorIs(bk.SendCoins(ctx, fromAddr, toAddr, amount), sdkerrors.ErrUnknownAddress)
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, toAddr))
+	res, err = bk.TransferAllowed(sdk.WrapSDKContext(ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Allowed)
+
+	app.BankKeeper.SetParams(ctx, types.DefaultParams().SetSendEnabledParam(fooDenom, false))
+	res, err = queryClient.TransferAllowed(gocontext.Background(), types.NewQueryTransferAllowedRequest(fromAddr, toAddr, amount))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Allowed)
+	suite.Require().Equal(types.TransferDeniedReasonSendDisabled, res.Reason)
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	res, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
@@ -106,6 +248,53 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	suite.Require().Equal(res.Supply, expectedTotalSupply)
 }
 
+func (suite *IntegrationTestSuite) TestQuerySupplyByDenoms() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.SupplyByDenoms(gocontext.Background(), &types.QuerySupplyByDenomsRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.SupplyByDenoms(gocontext.Background(), &types.QuerySupplyByDenomsRequest{Denoms: []string{fooDenom, ""}})
+	suite.Require().Error(err)
+
+	testCoins := sdk.NewCoins(newFooCoin(400), newBarCoin(300))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, testCoins))
+
+	res, err := queryClient.SupplyByDenoms(gocontext.Background(), &types.QuerySupplyByDenomsRequest{Denoms: []string{fooDenom, barDenom, "absent"}})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	expected := sdk.Coins{sdk.NewInt64Coin("absent", 0), newBarCoin(300), newFooCoin(400)}
+	suite.Require().Equal(expected, res.Supply)
+	suite.Require().Equal(expected, app.BankKeeper.GetSupplyOf(ctx, []string{fooDenom, barDenom, "absent", barDenom}))
+}
+
+func (suite *IntegrationTestSuite) TestQueryCirculatingSupply() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	_, err := queryClient.CirculatingSupply(gocontext.Background(), &types.QueryCirculatingSupplyRequest{ExcludedModuleAccounts: []string{"unknown"}})
+	suite.Require().Error(err)
+
+	testCoins := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, testCoins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, sdk.NewCoins(sdk.NewInt64Coin("test", 100000000))))
+
+	total, err := queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{})
+	suite.Require().NoError(err)
+
+	res, err := queryClient.CirculatingSupply(gocontext.Background(), &types.QueryCirculatingSupplyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(total.Supply, res.Supply)
+
+	res, err = queryClient.CirculatingSupply(gocontext.Background(), &types.QueryCirculatingSupplyRequest{ExcludedModuleAccounts: []string{minttypes.ModuleName, minttypes.ModuleName}})
+	suite.Require().NoError(err)
+
+	mintBalances := app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(minttypes.ModuleName))
+	suite.Require().Equal(total.Supply.Sub(mintBalances), res.Supply)
+	suite.Require().Equal(sdk.NewInt(100000000), res.Supply.AmountOf("test"))
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 
@@ -235,7 +424,7 @@ func (suite *IntegrationTestSuite) QueryDenomsMetadataRequest() {
 	}
 }
 
-func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
+func (suite *IntegrationTestSuite) TestQueryDenomMetadataRequest() {
 	var (
 		req         *types.QueryDenomMetadataRequest
 		expMetadata = types.Metadata{}
@@ -245,6 +434,7 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 		msg      string
 		malleate func()
 		expPass  bool
+		expCode  codes.Code
 	}{
 		{
 			"empty denom",
@@ -252,6 +442,7 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 				req = &types.QueryDenomMetadataRequest{}
 			},
 			false,
+			codes.InvalidArgument,
 		},
 		{
 			"not found denom",
@@ -261,11 +452,12 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 				}
 			},
 			false,
+			codes.NotFound,
 		},
 		{
 			"success",
 			func() {
-				expMetadata := types.Metadata{
+				expMetadata = types.Metadata{
 					Description: "The native staking token of the Cosmos Hub.",
 					DenomUnits: []*types.DenomUnit{
 						{
@@ -289,6 +481,7 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 				}
 			},
 			true,
+			codes.OK,
 		},
 	}
 
@@ -307,6 +500,7 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 				suite.Require().Equal(expMetadata, res.Metadata)
 			} else {
 				suite.Require().Error(err)
+				suite.Require().Equal(tc.expCode, status.Code(err))
 			}
 		})
 	}
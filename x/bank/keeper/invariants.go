@@ -12,6 +12,7 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding", NonnegativeBalanceInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-supply", NonnegativeSupplyInvariant(k))
 }
 
 // AllInvariants runs all invariants of the X/bank module.
@@ -47,6 +48,32 @@ func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 	}
 }
 
+// NonnegativeSupplyInvariant checks that the total supply of every denomination is non-negative
+func NonnegativeSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+			if supply.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\t%s has a negative total supply of %s\n", supply.Denom, supply.Amount)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "nonnegative-supply",
+			fmt.Sprintf("amount of negative supplies found %d\n%s", count, msg),
+		), broken
+	}
+}
+
 // TotalSupply checks that the total supply reflects all the coins held in accounts
 func TotalSupply(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -22,12 +22,15 @@ var _ Keeper = (*BaseKeeper)(nil)
 type Keeper interface {
 	SendKeeper
 	WithMintCoinsRestriction(MintingRestrictionFn) BaseKeeper
+	WithRecipientAccountCreationDisabled() BaseKeeper
 
 	InitGenesis(sdk.Context, *types.GenesisState)
 	ExportGenesis(sdk.Context) *types.GenesisState
 
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetSupplyOf(ctx sdk.Context, denoms []string) sdk.Coins
 	HasSupply(ctx sdk.Context, denom string) bool
+	GetSupplyCap(ctx sdk.Context, denom string) (sdk.Coin, bool)
 	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool)
 	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
@@ -136,6 +139,15 @@ func (k BaseKeeper) WithMintCoinsRestriction(check MintingRestrictionFn) BaseKee
 	return k
 }
 
+// WithRecipientAccountCreationDisabled returns a copy of the keeper that
+// rejects sends to addresses without an existing account, instead of creating
+// the account. This also applies to sends from module accounts, e.g. through
+// SendCoinsFromModuleToAccount.
+func (k BaseKeeper) WithRecipientAccountCreationDisabled() BaseKeeper {
+	k.BaseSendKeeper = k.BaseSendKeeper.WithRecipientAccountCreationDisabled()
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -241,6 +253,25 @@ func (k BaseKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
 	}
 }
 
+// GetSupplyOf returns the total supply of the given denominations. Denominations
+// without supply are returned as zero coins, and duplicate denominations are
+// only returned once.
+func (k BaseKeeper) GetSupplyOf(ctx sdk.Context, denoms []string) sdk.Coins {
+	supply := make(sdk.Coins, 0, len(denoms))
+	seen := make(map[string]bool, len(denoms))
+
+	for _, denom := range denoms {
+		if seen[denom] {
+			continue
+		}
+
+		seen[denom] = true
+		supply = append(supply, k.GetSupply(ctx, denom))
+	}
+
+	return supply.Sort()
+}
+
 // HasSupply checks if the supply coin exists in store.
 func (k BaseKeeper) HasSupply(ctx sdk.Context, denom string) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -248,6 +279,95 @@ func (k BaseKeeper) HasSupply(ctx sdk.Context, denom string) bool {
 	return supplyStore.Has(conv.UnsafeStrToBytes(denom))
 }
 
+// GetSupplyCap retrieves the maximum supply of the given denom, returning false
+// if the denom has no cap.
+func (k BaseKeeper) GetSupplyCap(ctx sdk.Context, denom string) (sdk.Coin, bool) {
+	store := ctx.KVStore(k.storeKey)
+	supplyCapStore := prefix.NewStore(store, types.SupplyCapPrefix)
+
+	bz := supplyCapStore.Get(conv.UnsafeStrToBytes(denom))
+	if bz == nil {
+		return sdk.Coin{}, false
+	}
+
+	var amount sdk.Int
+	err := amount.Unmarshal(bz)
+	if err != nil {
+		panic(fmt.Errorf("unable to unmarshal supply cap value %v", err))
+	}
+
+	return sdk.NewCoin(denom, amount), true
+}
+
+// SetSupplyCap sets the maximum supply of a denom. Minting coins that would
+// bring the total supply of the denom above its cap fails. An error is
+// returned if the cap is invalid or below the current total supply of the
+// denom.
+//
+// Supply caps are not part of the Keeper interface, so that only the app
+// holding the BaseKeeper can change them.
+func (k BaseKeeper) SetSupplyCap(ctx sdk.Context, supplyCap sdk.Coin) error {
+	if !supplyCap.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, supplyCap.String())
+	}
+
+	if supply := k.GetSupply(ctx, supplyCap.GetDenom()); supply.Amount.GT(supplyCap.Amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "supply cap %s is below the total supply %s", supplyCap, supply)
+	}
+
+	intBytes, err := supplyCap.Amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value %v", err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	supplyCapStore := prefix.NewStore(store, types.SupplyCapPrefix)
+	supplyCapStore.Set([]byte(supplyCap.GetDenom()), intBytes)
+
+	return nil
+}
+
+// DeleteSupplyCap removes the maximum supply of a denom.
+func (k BaseKeeper) DeleteSupplyCap(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	supplyCapStore := prefix.NewStore(store, types.SupplyCapPrefix)
+	supplyCapStore.Delete(conv.UnsafeStrToBytes(denom))
+}
+
+// GetAllSupplyCaps returns the supply caps of all capped denoms.
+func (k BaseKeeper) GetAllSupplyCaps(ctx sdk.Context) sdk.Coins {
+	supplyCaps := sdk.NewCoins()
+	k.IterateSupplyCaps(ctx, func(supplyCap sdk.Coin) bool {
+		supplyCaps = append(supplyCaps, supplyCap)
+		return false
+	})
+
+	return supplyCaps
+}
+
+// IterateSupplyCaps iterates over the supply caps of all capped denoms,
+// ordered by denom, calling the given cb (callback) function with each cap.
+// The iteration stops if the callback returns true.
+func (k BaseKeeper) IterateSupplyCaps(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	supplyCapStore := prefix.NewStore(store, types.SupplyCapPrefix)
+
+	iterator := supplyCapStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		err := amount.Unmarshal(iterator.Value())
+		if err != nil {
+			panic(fmt.Errorf("unable to unmarshal supply cap value %v", err))
+		}
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
+}
+
 // GetDenomMetaData retrieves the denomination metadata. returns the metadata and true if the denom exists,
 // false otherwise.
 func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
@@ -403,7 +523,8 @@ func (k BaseKeeper) UndelegateCoinsFromModuleToAccount(
 }
 
 // MintCoins creates new coins from thin air and adds it to the module account.
-// It will panic if the module account does not exist or is unauthorized.
+// It will panic if the module account does not exist or is unauthorized. An
+// error is returned if minting would exceed the supply cap of a denomination.
 func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
 	err := k.mintCoinsRestrictionFn(ctx, amounts)
 	if err != nil {
@@ -419,6 +540,23 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName))
 	}
 
+	for _, amount := range amounts {
+		supplyCap, found := k.GetSupplyCap(ctx, amount.GetDenom())
+		if !found {
+			continue
+		}
+
+		supply := k.GetSupply(ctx, amount.GetDenom())
+		if supply.Amount.Add(amount.Amount).GT(supplyCap.Amount) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint %s, total supply %s would exceed the supply cap %s", amount, supply, supplyCap)
+		}
+	}
+
+	// addCoins accepts zero amounts, but minting them is still an error
+	if !amounts.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
+	}
+
 	err = k.addCoins(ctx, acc.GetAddress(), amounts)
 	if err != nil {
 		return err
@@ -426,8 +564,12 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
-		supply = supply.Add(amount)
-		k.setSupply(ctx, supply)
+		newSupply := supply.Add(amount)
+		k.setSupply(ctx, newSupply)
+
+		ctx.EventManager().EmitEvent(
+			types.NewSupplyChangeEvent(amount.GetDenom(), supply.Amount, newSupply.Amount, types.AttributeValueReasonMint),
+		)
 	}
 
 	logger := k.Logger(ctx)
@@ -443,6 +585,8 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 
 // BurnCoins burns coins deletes coins from the balance of the module account.
 // It will panic if the module account does not exist or is unauthorized.
+// An error is returned, before any state is modified, if burning would make
+// the total supply of a denomination negative.
 func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
 	acc := k.ak.GetModuleAccount(ctx, moduleName)
 	if acc == nil {
@@ -453,23 +597,61 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
-	if err != nil {
+	if err := k.burnCoins(ctx, acc.GetAddress(), amounts); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+
+	return nil
+}
+
+// BurnCoinsFromAccount burns coins held by an account, removing them from its
+// spendable balance and from the total supply. It is not part of the Keeper
+// interface since, unlike BurnCoins, it requires no Burner permission: only
+// the app holding the BaseKeeper, e.g. through the bank Msg service, may burn
+// coins of arbitrary accounts.
+func (k BaseKeeper) BurnCoinsFromAccount(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
+	if err := k.burnCoins(ctx, addr, amounts); err != nil {
 		return err
 	}
 
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from account", "amount", amounts.String(), "from", addr.String())
+
+	return nil
+}
+
+// burnCoins deletes coins from the unlocked balance of addr and reduces the
+// total supply accordingly. An error is returned, before any state is modified,
+// if burning would make the total supply of a denomination negative.
+func (k BaseKeeper) burnCoins(ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
-		supply = supply.Sub(amount)
-		k.setSupply(ctx, supply)
+		if supply.Amount.LT(amount.Amount) {
+			return sdkerrors.Wrapf(types.ErrBurnExceedsSupply, "cannot burn %s, total supply is %s", amount, supply)
+		}
 	}
 
-	logger := k.Logger(ctx)
-	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
+	err := k.subUnlockedCoins(ctx, addr, amounts)
+	if err != nil {
+		return err
+	}
+
+	for _, amount := range amounts {
+		supply := k.GetSupply(ctx, amount.GetDenom())
+		newSupply := supply.Sub(amount)
+		k.setSupply(ctx, newSupply)
+
+		ctx.EventManager().EmitEvent(
+			types.NewSupplyChangeEvent(amount.GetDenom(), supply.Amount, newSupply.Amount, types.AttributeValueReasonBurn),
+		)
+	}
 
 	// emit burn event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
+		types.NewCoinBurnEvent(addr, amounts),
 	)
 
 	return nil
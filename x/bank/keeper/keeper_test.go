@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtime "github.com/tendermint/tendermint/libs/time"
@@ -12,7 +13,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -256,6 +260,40 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }) // nolint:errcheck
 }
 
+func (suite *IntegrationTestSuite) TestSupply_MintCoinsSupplyCap() {
+	ctx := suite.ctx
+	_, bk := suite.initKeepersWithmAccPerms(make(map[string]bool))
+
+	_, found := bk.GetSupplyCap(ctx, fooDenom)
+	suite.Require().False(found)
+
+	suite.Require().NoError(bk.SetSupplyCap(ctx, newFooCoin(100)))
+	supplyCap, found := bk.GetSupplyCap(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(newFooCoin(100), supplyCap)
+
+	suite.Require().NoError(bk.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(60))))
+	suite.Require().NoError(bk.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(40), newBarCoin(500))))
+	suite.Require().Equal(newFooCoin(100), bk.GetSupply(ctx, fooDenom))
+
+	err := bk.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(1), newBarCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	suite.Require().Equal(newFooCoin(100), bk.GetSupply(ctx, fooDenom))
+	suite.Require().Equal(newBarCoin(500), bk.GetSupply(ctx, barDenom))
+
+	// a cap below the current supply would block all further minting
+	err = bk.SetSupplyCap(ctx, newFooCoin(99))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	supplyCap, _ = bk.GetSupplyCap(ctx, fooDenom)
+	suite.Require().Equal(newFooCoin(100), supplyCap)
+
+	bk.DeleteSupplyCap(ctx, fooDenom)
+	suite.Require().NoError(bk.MintCoins(ctx, authtypes.Minter, sdk.NewCoins(newFooCoin(1))))
+
+	err = bk.SetSupplyCap(ctx, sdk.Coin{Denom: fooDenom, Amount: sdk.NewInt(-1)})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+}
+
 func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	ctx := suite.ctx
 	// add module accounts to supply keeper
@@ -307,6 +345,116 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 	suite.Require().Equal(supplyAfterInflation.Sub(initCoins), supplyAfterBurn)
 }
 
+func (suite *IntegrationTestSuite) TestSupply_BurnCoinsExceedingSupply() {
+	app, ctx := suite.app, suite.ctx
+	authKeeper, bk := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	authKeeper.SetModuleAccount(ctx, burnerAcc)
+
+	fooCoins := sdk.NewCoins(newFooCoin(100))
+	suite.Require().NoError(bk.MintCoins(ctx, authtypes.Minter, fooCoins))
+	suite.Require().NoError(bk.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, burnerAcc.GetAddress(), fooCoins))
+	initialSupply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+
+	// credit the burner beyond the total supply by writing its balance directly
+	drifted := fooCoins.Add(fooCoins...)
+	amountBz, err := drifted[0].Amount.Marshal()
+	suite.Require().NoError(err)
+	accountStore := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.CreateAccountBalancesPrefix(burnerAcc.GetAddress()))
+	accountStore.Set([]byte(drifted[0].Denom), amountBz)
+
+	err = bk.BurnCoins(ctx, authtypes.Burner, drifted)
+	suite.Require().ErrorIs(err, types.ErrBurnExceedsSupply)
+
+	supply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialSupply, supply)
+	suite.Require().Equal(drifted, bk.GetAllBalances(ctx, burnerAcc.GetAddress()))
+
+	_, broken := keeper.NonnegativeSupplyInvariant(bk)(ctx)
+	suite.Require().False(broken)
+
+	// a negative supply can only come from a corrupted store and must break the invariant
+	negativeBz, err := sdk.NewInt(-1).Marshal()
+	suite.Require().NoError(err)
+	supplyStore := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.SupplyKey)
+	supplyStore.Set([]byte(barDenom), negativeBz)
+
+	_, broken = keeper.NonnegativeSupplyInvariant(bk)(ctx)
+	suite.Require().True(broken)
+}
+
+func (suite *IntegrationTestSuite) TestMsgBurn() {
+	ctx := suite.ctx
+	holderAddr := authtypes.NewModuleAddress(holder)
+	authKeeper, bk := suite.initKeepersWithmAccPerms(map[string]bool{holderAddr.String(): true})
+	msgServer := keeper.NewMsgServerImpl(bk)
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr))
+
+	fooCoins := sdk.NewCoins(newFooCoin(100))
+	suite.Require().NoError(bk.MintCoins(ctx, authtypes.Minter, fooCoins.Add(fooCoins...)))
+	suite.Require().NoError(bk.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, addr, fooCoins))
+
+	burn := sdk.NewCoins(newFooCoin(40))
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, burn))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60)), bk.GetAllBalances(ctx, addr))
+	suite.Require().Equal(newFooCoin(160), bk.GetSupply(ctx, fooDenom))
+
+	// burning more than the spendable balance fails without modifying state
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, fooCoins))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60)), bk.GetAllBalances(ctx, addr))
+	suite.Require().Equal(newFooCoin(160), bk.GetSupply(ctx, fooDenom))
+
+	// blocked addresses, e.g. module accounts, cannot burn their funds
+	holderAcc := authtypes.NewEmptyModuleAccount(holder)
+	authKeeper.SetModuleAccount(ctx, holderAcc)
+	suite.Require().NoError(bk.SendCoins(ctx, addr, holderAddr, burn))
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(holderAddr, burn))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(burn, bk.GetAllBalances(ctx, holderAddr))
+	suite.Require().Equal(newFooCoin(160), bk.GetSupply(ctx, fooDenom))
+
+	// burns are subject to the send-enabled parameters
+	bk.SetParams(ctx, types.DefaultParams().SetSendEnabledParam(fooDenom, false))
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, burn))
+	suite.Require().ErrorIs(err, types.ErrSendDisabled)
+}
+
+func (suite *IntegrationTestSuite) TestSendZeroCoins() {
+	app, ctx := suite.app, suite.ctx
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr2))
+
+	// crediting an empty amount neither writes a balance nor emits a coin_received event
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins()))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).Empty())
+	for _, event := range ctx.EventManager().Events() {
+		suite.Require().NotEqual(types.EventTypeCoinReceived, event.Type)
+	}
+
+	// an empty send still creates the recipient account
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins()))
+	suite.Require().True(app.AccountKeeper.HasAccount(ctx, addr3))
+
+	// an all-zero amount is still rejected
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.Coins{sdk.NewInt64Coin(fooDenom, 0)})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// minting zero coins is still rejected
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(fooDenom, 0)})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	suite.Require().True(app.BankKeeper.GetSupply(ctx, fooDenom).IsZero())
+}
+
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -421,6 +569,198 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 	suite.Require().Equal(expected, acc3Balances)
 }
 
+func (suite *IntegrationTestSuite) TestAirdropSend() {
+	app, ctx := suite.app, suite.ctx
+
+	from := sdk.AccAddress([]byte("airdrop_sender______"))
+	existing := sdk.AccAddress([]byte("airdrop_existing____"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, existing))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, from, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	recipients := []sdk.AccAddress{
+		existing,
+		sdk.AccAddress([]byte("airdrop_new1________")),
+		sdk.AccAddress([]byte("airdrop_new2________")),
+	}
+	amounts := []sdk.Coins{
+		sdk.NewCoins(newFooCoin(10)),
+		sdk.NewCoins(newFooCoin(20), newBarCoin(5)),
+		sdk.NewCoins(newBarCoin(15)),
+	}
+
+	// the same transfer expressed as a multisend with a single input
+	total := sdk.NewCoins()
+	outputs := make([]types.Output, len(recipients))
+	for i, to := range recipients {
+		total = total.Add(amounts[i]...)
+		outputs[i] = types.NewOutput(to, amounts[i])
+	}
+	inputs := []types.Input{types.NewInput(from, total)}
+
+	airdropCtx, _ := ctx.CacheContext()
+	suite.Require().NoError(app.BankKeeper.AirdropSend(airdropCtx, from, recipients, amounts))
+
+	multiSendCtx, _ := ctx.CacheContext()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(multiSendCtx, inputs, outputs))
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(70), newBarCoin(80)), app.BankKeeper.GetAllBalances(airdropCtx, from))
+	suite.Require().Equal(app.BankKeeper.GetAllBalances(multiSendCtx, from), app.BankKeeper.GetAllBalances(airdropCtx, from))
+	for i, to := range recipients {
+		suite.Require().Equal(amounts[i], app.BankKeeper.GetAllBalances(airdropCtx, to))
+		suite.Require().True(app.AccountKeeper.HasAccount(airdropCtx, to))
+	}
+	suite.Require().NotEmpty(airdropCtx.EventManager().Events())
+	suite.Require().Equal(multiSendCtx.EventManager().Events(), airdropCtx.EventManager().Events())
+
+	// amounts of a recipient listed several times are added up
+	dupRecipients := append(recipients, recipients[1], existing)
+	dupAmounts := append(amounts, sdk.NewCoins(newFooCoin(1)), sdk.NewCoins(newBarCoin(2), newFooCoin(3)))
+	dupOutputs := append(outputs, types.NewOutput(recipients[1], dupAmounts[3]), types.NewOutput(existing, dupAmounts[4]))
+	dupInputs := []types.Input{types.NewInput(from, total.Add(dupAmounts[3]...).Add(dupAmounts[4]...))}
+
+	airdropCtx, _ = ctx.CacheContext()
+	suite.Require().NoError(app.BankKeeper.AirdropSend(airdropCtx, from, dupRecipients, dupAmounts))
+
+	multiSendCtx, _ = ctx.CacheContext()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(multiSendCtx, dupInputs, dupOutputs))
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(13), newBarCoin(2)), app.BankKeeper.GetAllBalances(airdropCtx, existing))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(21), newBarCoin(5)), app.BankKeeper.GetAllBalances(airdropCtx, recipients[1]))
+	for _, addr := range append(recipients, from) {
+		suite.Require().Equal(app.BankKeeper.GetAllBalances(multiSendCtx, addr), app.BankKeeper.GetAllBalances(airdropCtx, addr))
+	}
+	suite.Require().Equal(multiSendCtx.EventManager().Events(), airdropCtx.EventManager().Events())
+
+	// recipients and amounts must line up
+	err := app.BankKeeper.AirdropSend(ctx, from, recipients, amounts[:2])
+	suite.Require().ErrorIs(err, types.ErrInputOutputMismatch)
+
+	err = app.BankKeeper.AirdropSend(ctx, from, nil, nil)
+	suite.Require().ErrorIs(err, types.ErrNoOutputs)
+
+	// blocked addresses cannot receive funds
+	blocked := authtypes.NewModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(blocked))
+	err = app.BankKeeper.AirdropSend(ctx, from, append(recipients, blocked), append(amounts, sdk.NewCoins(newFooCoin(1))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(100)), app.BankKeeper.GetAllBalances(ctx, from))
+
+	// every amount must be positive
+	err = app.BankKeeper.AirdropSend(ctx, from, recipients[:1], []sdk.Coins{sdk.NewCoins()})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// the sender must cover the total
+	err = app.BankKeeper.AirdropSend(ctx, from, recipients[:2], []sdk.Coins{sdk.NewCoins(newFooCoin(60)), sdk.NewCoins(newFooCoin(60))})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(100), newBarCoin(100)), app.BankKeeper.GetAllBalances(ctx, from))
+
+	app.BankKeeper.SetParams(ctx, types.DefaultParams().SetSendEnabledParam(barDenom, false))
+	err = app.BankKeeper.AirdropSend(ctx, from, recipients, amounts)
+	suite.Require().ErrorIs(err, types.ErrSendDisabled)
+}
+
+func (suite *IntegrationTestSuite) TestRecipientAccountCreationDisabled() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr2))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	bk := app.BankKeeper.WithRecipientAccountCreationDisabled()
+
+	// sends to existing accounts are unaffected
+	suite.Require().NoError(bk.SendCoins(ctx, addr1, addr2, sendAmt))
+
+	// sends to an address without an account fail without modifying state
+	err := bk.SendCoins(ctx, addr1, addr3, sendAmt)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	inputs := []types.Input{types.NewInput(addr1, sendAmt.Add(sendAmt...))}
+	outputs := []types.Output{types.NewOutput(addr2, sendAmt), types.NewOutput(addr3, sendAmt)}
+	err = bk.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	err = bk.AirdropSend(ctx, addr1, []sdk.AccAddress{addr2, addr3}, []sdk.Coins{sendAmt, sendAmt})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90)), bk.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sendAmt, bk.GetAllBalances(ctx, addr2))
+	suite.Require().False(app.AccountKeeper.HasAccount(ctx, addr3))
+
+	// the default keeper still creates the recipient account
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sendAmt))
+	suite.Require().True(app.AccountKeeper.HasAccount(ctx, addr3))
+	suite.Require().Equal(sendAmt, bk.GetAllBalances(ctx, addr3))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsSendDisabled() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(90), newBarCoin(30))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	app.BankKeeper.SetParams(ctx, types.DefaultParams().SetSendEnabledParam(fooDenom, false))
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))}}
+	outputs := []types.Output{{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))}}
+	err := app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, types.ErrSendDisabled)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).Empty())
+
+	inputs = []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newBarCoin(10))}}
+	outputs = []types.Output{{Address: addr2.String(), Coins: sdk.NewCoins(newBarCoin(10))}}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsTelemetry() {
+	app, ctx := suite.app, suite.ctx
+
+	m, err := telemetry.New(telemetry.Config{Enabled: true})
+	suite.Require().NoError(err)
+	defer func() {
+		// restore the blackhole sink installed by go-metrics by default
+		_, err := metrics.NewGlobal(&metrics.Config{}, &metrics.BlackholeSink{})
+		suite.Require().NoError(err)
+	}()
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(1000))))
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(1000))}}
+	outputs := make([]types.Output, 0, 100)
+	for i := 0; i < 100; i++ {
+		addr := sdk.AccAddress([]byte(fmt.Sprintf("multisend_output%04d", i)))
+		outputs = append(outputs, types.Output{Address: addr.String(), Coins: sdk.NewCoins(newFooCoin(10))})
+	}
+
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr1).Empty())
+	for _, out := range outputs {
+		outAddr, err := sdk.AccAddressFromBech32(out.Address)
+		suite.Require().NoError(err)
+		suite.Require().Equal(out.Coins, app.BankKeeper.GetAllBalances(ctx, outAddr))
+	}
+
+	res, err := m.Gather(telemetry.FormatDefault)
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(res.Metrics), "tx.msg.multisend.outputs")
+	suite.Require().Contains(string(res.Metrics), "tx.msg.multisend.inputs")
+}
+
 func (suite *IntegrationTestSuite) TestSendCoins() {
 	app, ctx := suite.app, suite.ctx
 	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
@@ -484,6 +824,45 @@ func (suite *IntegrationTestSuite) TestValidateBalance() {
 	suite.Require().Error(app.BankKeeper.ValidateBalance(ctx, addr2))
 }
 
+func (suite *IntegrationTestSuite) TestGetParamsDecodeCache() {
+	app, ctx := suite.app, suite.ctx
+	fooCoin := sdk.NewCoin(fooDenom, sdk.OneInt())
+
+	params := types.DefaultParams().SetSendEnabledParam(fooDenom, true)
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().Equal(params, app.BankKeeper.GetParams(ctx))
+
+	// modifying returned params must not affect later reads
+	cached := app.BankKeeper.GetParams(ctx)
+	cached.SendEnabled[0].Enabled = false
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+
+	// SetParams mid-block is observed by the next read
+	params = params.SetSendEnabledParam(fooDenom, false)
+	app.BankKeeper.SetParams(ctx, params)
+	suite.Require().Equal(params, app.BankKeeper.GetParams(ctx))
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+
+	// changes written directly to the subspace, as done by governance, are observed
+	app.GetSubspace(types.ModuleName).Set(ctx, types.KeySendEnabled, []*types.SendEnabled{types.NewSendEnabled(fooDenom, true)})
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+
+	// changes in a discarded branch of the state are not served afterwards
+	cacheCtx, _ := ctx.CacheContext()
+	app.BankKeeper.SetParams(cacheCtx, params)
+	suite.Require().False(app.BankKeeper.IsSendEnabledCoin(cacheCtx, fooCoin))
+	suite.Require().True(app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+
+	// reads consume the same gas whether or not the decode cache is warm
+	app.BankKeeper.SetParams(ctx, params)
+	coldCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	app.BankKeeper.GetParams(coldCtx)
+	warmCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	app.BankKeeper.GetParams(warmCtx)
+	suite.Require().NotZero(coldCtx.GasMeter().GasConsumed())
+	suite.Require().Equal(coldCtx.GasMeter().GasConsumed(), warmCtx.GasMeter().GasConsumed())
+}
+
 func (suite *IntegrationTestSuite) TestSendEnabled() {
 	app, ctx := suite.app, suite.ctx
 	enabled := true
@@ -582,9 +961,9 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 
 	// events are shifted due to the funding account events
 	events := ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(10, len(events))
-	suite.Require().Equal(abci.Event(event1), events[8])
-	suite.Require().Equal(abci.Event(event2), events[9])
+	suite.Require().Equal(11, len(events))
+	suite.Require().Equal(abci.Event(event1), events[9])
+	suite.Require().Equal(abci.Event(event2), events[10])
 }
 
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
@@ -623,7 +1002,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(8, len(events)) // 7 events because account funding causes extra minting + coin_spent + coin_recv events
+	suite.Require().Equal(9, len(events)) // 9 events because account funding causes extra minting + supply_change + coin_spent + coin_recv events
 
 	event1 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -633,7 +1012,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		event1.Attributes,
 		abci.EventAttribute{Key: types.AttributeKeySender, Value: addr.String()},
 	)
-	suite.Require().Equal(abci.Event(event1), events[7])
+	suite.Require().Equal(abci.Event(event1), events[8])
 
 	// Set addr's coins and addr2's coins
 	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50))))
@@ -645,7 +1024,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(28, len(events)) // 25 due to account funding + coin_spent + coin_recv events
+	suite.Require().Equal(31, len(events)) // 31 due to account funding + supply_change + coin_spent + coin_recv events
 
 	event2 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -679,10 +1058,10 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins2.String()},
 	)
 	// events are shifted due to the funding account events
-	suite.Require().Equal(abci.Event(event1), events[21])
-	suite.Require().Equal(abci.Event(event2), events[23])
-	suite.Require().Equal(abci.Event(event3), events[25])
-	suite.Require().Equal(abci.Event(event4), events[27])
+	suite.Require().Equal(abci.Event(event1), events[24])
+	suite.Require().Equal(abci.Event(event2), events[26])
+	suite.Require().Equal(abci.Event(event3), events[28])
+	suite.Require().Equal(abci.Event(event4), events[30])
 }
 
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
@@ -745,6 +1124,113 @@ func (suite *IntegrationTestSuite) TestVestingAccountSend() {
 	suite.Require().Equal(origCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
 }
 
+func (suite *IntegrationTestSuite) TestLockedCoinsProvider() {
+	ctx := suite.ctx
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr1))
+
+	origCoins := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.Require().NoError(testutil.FundAccount(keeper, ctx, addr1, origCoins))
+
+	// lock 80foo of addr1 from an external module
+	keeper.RegisterLockedCoinsProvider(func(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+		if addr.Equals(addr1) {
+			return sdk.NewCoins(newFooCoin(80))
+		}
+		return nil
+	})
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(80)), keeper.LockedCoins(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(100)), keeper.SpendableCoins(ctx, addr1))
+	suite.Require().True(keeper.LockedCoins(ctx, addr2).IsZero())
+
+	err := keeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(50)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	suite.Require().Equal(origCoins, keeper.GetAllBalances(ctx, addr1))
+
+	// the unlocked remainder and other denoms can still be sent
+	suite.Require().NoError(keeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(20), newBarCoin(100))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(80)), keeper.GetAllBalances(ctx, addr1))
+}
+
+func (suite *IntegrationTestSuite) TestLockedCoinsProviderExceedsBalance() {
+	ctx := suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	// addr1 holds 50foo and a provider locks 80foo
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr1))
+	suite.Require().NoError(testutil.FundAccount(keeper, ctx, addr1, sdk.NewCoins(newFooCoin(50), newBarCoin(100))))
+
+	// addr2 holds 100foo, all of it vesting, and a provider locks 1foo more
+	vestingCoins := sdk.NewCoins(newFooCoin(100))
+	bacc := authtypes.NewBaseAccountWithAddress(addr2)
+	vacc := vesting.NewContinuousVestingAccount(bacc, vestingCoins, now.Unix(), now.Add(24*time.Hour).Unix())
+	authKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(testutil.FundAccount(keeper, ctx, addr2, vestingCoins.Add(newBarCoin(100))))
+
+	keeper.RegisterLockedCoinsProvider(func(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+		switch {
+		case addr.Equals(addr1):
+			return sdk.NewCoins(newFooCoin(80))
+		case addr.Equals(addr2):
+			return sdk.NewCoins(newFooCoin(1))
+		}
+		return nil
+	})
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		// only the over-locked denom is unspendable
+		suite.Require().Equal(sdk.NewCoins(newBarCoin(100)), keeper.SpendableCoins(ctx, addr))
+
+		err := keeper.SendCoins(ctx, addr, addr3, sdk.NewCoins(newFooCoin(1)))
+		suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+		suite.Require().NoError(keeper.SendCoins(ctx, addr, addr3, sdk.NewCoins(newBarCoin(100))))
+	}
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), keeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(vestingCoins, keeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestLockedCoinsProviderInvalidCoins() {
+	ctx := suite.ctx
+	_, bk := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	var provided sdk.Coins
+	bk.RegisterLockedCoinsProvider(func(_ sdk.Context, _ sdk.AccAddress) sdk.Coins {
+		return provided
+	})
+
+	// unsorted coins and zero coins are sanitized
+	provided = sdk.Coins{newFooCoin(10), newBarCoin(5), sdk.NewInt64Coin("zero", 0)}
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(5), newFooCoin(10)), bk.LockedCoins(ctx, addr))
+
+	// duplicate denoms and negative amounts are rejected
+	provided = sdk.Coins{newFooCoin(10), newFooCoin(5)}
+	suite.Require().PanicsWithError(
+		fmt.Sprintf("locked coins provider 0 returned invalid coins 10foo,5foo for %s: duplicate denomination foo", addr),
+		func() { bk.LockedCoins(ctx, addr) },
+	)
+
+	provided = sdk.Coins{sdk.Coin{Denom: fooDenom, Amount: sdk.NewInt(-1)}}
+	suite.Require().Panics(func() { bk.LockedCoins(ctx, addr) })
+
+	// a zero value keeper cannot hold providers
+	suite.Require().Panics(func() {
+		keeper.BaseViewKeeper{}.RegisterLockedCoinsProvider(func(sdk.Context, sdk.AccAddress) sdk.Coins { return nil })
+	})
+}
+
 func (suite *IntegrationTestSuite) TestPeriodicVestingAccountSend() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1139,6 +1625,32 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	})
 }
 
+func (suite *IntegrationTestSuite) TestSupplyChangeEvents() {
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	authKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
+
+	initialSupply := keeper.GetSupply(suite.ctx, fooDenom).Amount
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(keeper.MintCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(1000))))
+	suite.Require().NoError(keeper.BurnCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(300))))
+
+	var supplyEvents []sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeSupplyChange {
+			supplyEvents = append(supplyEvents, e)
+		}
+	}
+	suite.Require().Len(supplyEvents, 2)
+
+	expected := []sdk.Event{
+		types.NewSupplyChangeEvent(fooDenom, initialSupply, initialSupply.AddRaw(1000), types.AttributeValueReasonMint),
+		types.NewSupplyChangeEvent(fooDenom, initialSupply.AddRaw(1000), initialSupply.AddRaw(700), types.AttributeValueReasonBurn),
+	}
+	suite.Require().Equal(expected, supplyEvents)
+	suite.Require().Equal(initialSupply.AddRaw(700), keeper.GetSupply(ctx, fooDenom).Amount)
+}
+
 func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 	return []types.Metadata{
 		{
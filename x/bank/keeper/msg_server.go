@@ -12,13 +12,13 @@ import (
 )
 
 type msgServer struct {
-	Keeper
+	BaseKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the bank MsgServer interface
-// for the provided Keeper.
-func NewMsgServerImpl(keeper Keeper) types.MsgServer {
-	return &msgServer{Keeper: keeper}
+// for the provided BaseKeeper.
+func NewMsgServerImpl(keeper BaseKeeper) types.MsgServer {
+	return &msgServer{BaseKeeper: keeper}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -74,12 +74,7 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// NOTE: totalIn == totalOut should already have been checked
-	for _, in := range msg.Inputs {
-		if err := k.IsSendEnabledCoins(ctx, in.Coins...); err != nil {
-			return nil, err
-		}
-	}
-
+	// NOTE: send enabled coins are checked by InputOutputCoins
 	for _, out := range msg.Outputs {
 		accAddr, err := sdk.AccAddressFromBech32(out.Address)
 		if err != nil {
@@ -104,3 +99,34 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(from) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to burn funds", msg.FromAddress)
+	}
+
+	err = k.BurnCoinsFromAccount(ctx, from, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"bytes"
+	"sync/atomic"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -18,6 +21,7 @@ type SendKeeper interface {
 	ViewKeeper
 
 	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error
+	AirdropSend(ctx sdk.Context, from sdk.AccAddress, recipients []sdk.AccAddress, amounts []sdk.Coins) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	GetParams(ctx sdk.Context) types.Params
@@ -43,6 +47,22 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// paramsDecodeCache holds the most recently decoded *decodedParams. It is
+	// shared by all copies of the keeper, and thus by all contexts.
+	paramsDecodeCache *atomic.Value
+
+	// when set, sends to addresses without an account fail instead of
+	// creating the account
+	disableRecipientAccountCreation bool
+}
+
+// decodedParams is a decoded set of bank parameters along with the raw
+// parameter store values it was decoded from.
+type decodedParams struct {
+	sendEnabled        []byte
+	defaultSendEnabled []byte
+	params             types.Params
 }
 
 func NewBaseSendKeeper(
@@ -50,29 +70,81 @@ func NewBaseSendKeeper(
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:    NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:               cdc,
+		ak:                ak,
+		storeKey:          storeKey,
+		paramSpace:        paramSpace,
+		blockedAddrs:      blockedAddrs,
+		paramsDecodeCache: &atomic.Value{},
 	}
 }
 
+// WithRecipientAccountCreationDisabled returns a copy of the keeper that
+// rejects sends to addresses without an existing account, instead of creating
+// the account, for chains that provision accounts explicitly.
+func (k BaseSendKeeper) WithRecipientAccountCreationDisabled() BaseSendKeeper {
+	k.disableRecipientAccountCreation = true
+	return k
+}
+
 // GetParams returns the total set of bank parameters.
+//
+// GetParams reads the parameter store on every call, like GetParamSet does, so
+// it consumes the same gas whether or not the decode cache is warm. Only the
+// amino JSON decoding is cached: the decoded parameters are reused as long as
+// the raw values read from the store match the ones they were decoded from.
+// Parameters changed outside of SetParams (e.g. by a governance proposal) or
+// rolled back with a failed transaction are thus never served stale. The
+// cache is not a block-scoped read cache and does not save any store read.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	sendEnabledBz := k.paramSpace.GetRaw(ctx, types.KeySendEnabled)
+	defaultSendEnabledBz := k.paramSpace.GetRaw(ctx, types.KeyDefaultSendEnabled)
+
+	if entry, _ := k.paramsDecodeCache.Load().(*decodedParams); entry != nil &&
+		bytes.Equal(entry.sendEnabled, sendEnabledBz) &&
+		bytes.Equal(entry.defaultSendEnabled, defaultSendEnabledBz) {
+		return copyParams(entry.params)
+	}
+
+	// decode with the subspace codec; the values were already read and paid
+	// for above, so reading them again must not consume gas
+	k.paramSpace.GetParamSet(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), &params)
+
+	k.paramsDecodeCache.Store(&decodedParams{
+		sendEnabled:        sendEnabledBz,
+		defaultSendEnabled: defaultSendEnabledBz,
+		params:             copyParams(params),
+	})
+
 	return params
 }
 
 // SetParams sets the total set of bank parameters.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
+	k.paramsDecodeCache.Store((*decodedParams)(nil))
+}
+
+// copyParams returns a deep copy of params, so that callers can never modify
+// a cached value.
+func copyParams(params types.Params) types.Params {
+	if params.SendEnabled == nil {
+		return params
+	}
+
+	sendEnabled := make(types.SendEnabledParams, len(params.SendEnabled))
+	for i, se := range params.SendEnabled {
+		sendEnabled[i] = types.NewSendEnabled(se.Denom, se.Enabled)
+	}
+
+	return types.NewParams(params.DefaultSendEnabled, sendEnabled)
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
+// inputs and outputs don't lineup, if any input denomination is not send
+// enabled or if any single transfer of tokens fails.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -80,6 +152,25 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	for _, in := range inputs {
+		if err := k.IsSendEnabledCoins(ctx, in.Coins...); err != nil {
+			return err
+		}
+	}
+
+	if k.disableRecipientAccountCreation {
+		for _, out := range outputs {
+			outAddress, err := sdk.AccAddressFromBech32(out.Address)
+			if err != nil {
+				return err
+			}
+
+			if err := k.checkRecipientAccount(ctx, outAddress); err != nil {
+				return err
+			}
+		}
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -128,12 +219,126 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
+	telemetry.IncrCounter(float32(len(inputs)), "tx", "msg", "multisend", "inputs")
+	telemetry.IncrCounter(float32(len(outputs)), "tx", "msg", "multisend", "outputs")
+
+	return nil
+}
+
+// AirdropSend transfers amounts[i] from a single sender to recipients[i] for
+// every i. It has the same effects as InputOutputCoins with a single input
+// covering the total of all amounts, without requiring matching inputs and
+// outputs to be built: the sender is debited once for the total, and the
+// amounts are aggregated per recipient so that every recipient balance is
+// read and written once per denomination, even if the recipient is listed
+// several times. An error is returned if the recipients and amounts don't
+// line up, if any recipient is a blocked address, if any denomination is not
+// send enabled or if any transfer of tokens fails.
+func (k BaseSendKeeper) AirdropSend(ctx sdk.Context, from sdk.AccAddress, recipients []sdk.AccAddress, amounts []sdk.Coins) error {
+	if len(recipients) == 0 {
+		return types.ErrNoOutputs
+	}
+
+	if len(recipients) != len(amounts) {
+		return sdkerrors.Wrapf(types.ErrInputOutputMismatch, "%d recipients but %d amounts", len(recipients), len(amounts))
+	}
+
+	total := sdk.NewCoins()
+	credits := make(map[string]sdk.Coins, len(recipients))
+	uniqueRecipients := make([]sdk.AccAddress, 0, len(recipients))
+	for i, amt := range amounts {
+		to := recipients[i]
+		if err := sdk.VerifyAddressFormat(to); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		}
+
+		if k.BlockedAddr(to) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+		}
+
+		if !amt.IsValid() || !amt.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+		}
+
+		credit, seen := credits[string(to)]
+		if !seen {
+			if err := k.checkRecipientAccount(ctx, to); err != nil {
+				return err
+			}
+
+			uniqueRecipients = append(uniqueRecipients, to)
+		}
+
+		credits[string(to)] = credit.Add(amt...)
+		total = total.Add(amt...)
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, total...); err != nil {
+		return err
+	}
+
+	err := k.subUnlockedCoins(ctx, from, total)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, from.String()),
+		),
+	)
+
+	newAccounts := 0
+	for _, to := range uniqueRecipients {
+		for _, coin := range credits[string(to)] {
+			balance := k.GetBalance(ctx, to, coin.Denom)
+			if err := k.setBalance(ctx, to, balance.Add(coin)); err != nil {
+				return err
+			}
+		}
+
+		// Create account if recipient does not exist.
+		accExists := k.ak.HasAccount(ctx, to)
+		if !accExists {
+			newAccounts++
+			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, to))
+		}
+	}
+
+	if newAccounts > 0 {
+		defer telemetry.IncrCounter(float32(newAccounts), "new", "account")
+	}
+
+	// emit the same events as InputOutputCoins, one pair per transfer
+	events := make(sdk.Events, 0, 2*len(recipients))
+	for i, to := range recipients {
+		toAddrString := to.String()
+		events = append(events,
+			sdk.NewEvent(
+				types.EventTypeCoinReceived,
+				sdk.NewAttribute(types.AttributeKeyReceiver, toAddrString),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amounts[i].String()),
+			),
+			sdk.NewEvent(
+				types.EventTypeTransfer,
+				sdk.NewAttribute(types.AttributeKeyRecipient, toAddrString),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amounts[i].String()),
+			),
+		)
+	}
+	ctx.EventManager().EmitEvents(events)
+
 	return nil
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkRecipientAccount(ctx, toAddr); err != nil {
+		return err
+	}
+
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -172,6 +377,16 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 	return nil
 }
 
+// checkRecipientAccount returns an error if recipient account creation is
+// disabled and addr has no account.
+func (k BaseSendKeeper) checkRecipientAccount(ctx sdk.Context, addr sdk.AccAddress) error {
+	if k.disableRecipientAccountCreation && !k.ak.HasAccount(ctx, addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	return nil
+}
+
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
 // A coin_spent event is emitted after.
@@ -184,7 +399,9 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, a
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
-		locked := sdk.NewCoin(coin.Denom, lockedCoins.AmountOf(coin.Denom))
+		// cap the locked amount at the balance, it may exceed it when a
+		// provider locks more than the account holds
+		locked := sdk.NewCoin(coin.Denom, sdk.MinInt(lockedCoins.AmountOf(coin.Denom), balance.Amount))
 		spendable := balance.Sub(locked)
 
 		_, hasNeg := sdk.Coins{spendable}.SafeSub(sdk.Coins{coin})
@@ -208,8 +425,21 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx sdk.Context, addr sdk.AccAddress, a
 }
 
 // addCoins increase the addr balance by the given amt. Fails if the provided amt is invalid.
-// It emits a coin received event.
+// It emits a coin received event. Zero coins are skipped, so adding an empty or
+// all-zero amt is a no-op returning nil: nothing is written and no event is emitted.
 func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	nonZero := make(sdk.Coins, 0, len(amt))
+	for _, coin := range amt {
+		if !coin.IsZero() {
+			nonZero = append(nonZero, coin)
+		}
+	}
+
+	if nonZero.Empty() {
+		return nil
+	}
+
+	amt = nonZero
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -24,6 +24,7 @@ type ViewKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetAccountsBalances(ctx sdk.Context) []types.Balance
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetBalances(ctx sdk.Context, addr sdk.AccAddress, denoms []string) sdk.Coins
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 
@@ -31,22 +32,45 @@ type ViewKeeper interface {
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 }
 
+// LockedCoinsProviderFn returns the coins an external module locks for the
+// given account, on top of the coins locked by the account itself.
+type LockedCoinsProviderFn func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
 type BaseViewKeeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
 	ak       types.AccountKeeper
+
+	// lockedCoinsProviders is shared by all copies of the keeper so that
+	// providers registered after the keeper has been handed to other modules
+	// are still honored.
+	lockedCoinsProviders *[]LockedCoinsProviderFn
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
 func NewBaseViewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper) BaseViewKeeper {
 	return BaseViewKeeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		ak:       ak,
+		cdc:                  cdc,
+		storeKey:             storeKey,
+		ak:                   ak,
+		lockedCoinsProviders: &[]LockedCoinsProviderFn{},
 	}
 }
 
+// RegisterLockedCoinsProvider registers a function whose result is added to
+// the coins returned by LockedCoins, allowing other modules (e.g. escrow or
+// custom staking locks) to restrict an account's spendable balance. It is
+// meant to be called during app wiring, before any block is processed, on a
+// keeper created with NewBaseViewKeeper or one of the constructors built on it.
+func (k BaseViewKeeper) RegisterLockedCoinsProvider(fn LockedCoinsProviderFn) {
+	if k.lockedCoinsProviders == nil {
+		panic("cannot register a locked coins provider on a keeper not created with NewBaseViewKeeper")
+	}
+
+	*k.lockedCoinsProviders = append(*k.lockedCoinsProviders, fn)
+}
+
 // Logger returns a module-specific logger.
 func (k BaseViewKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -111,6 +135,25 @@ func (k BaseViewKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom s
 	return sdk.NewCoin(denom, amount)
 }
 
+// GetBalances returns the balances of the given denominations for an account
+// by address. Denominations the account does not hold are returned as zero
+// coins, and duplicate denominations are only returned once.
+func (k BaseViewKeeper) GetBalances(ctx sdk.Context, addr sdk.AccAddress, denoms []string) sdk.Coins {
+	balances := make(sdk.Coins, 0, len(denoms))
+	seen := make(map[string]bool, len(denoms))
+
+	for _, denom := range denoms {
+		if seen[denom] {
+			continue
+		}
+
+		seen[denom] = true
+		balances = append(balances, k.GetBalance(ctx, addr, denom))
+	}
+
+	return balances.Sort()
+}
+
 // IterateAccountBalances iterates over the balances of a single account and
 // provides the token balance to a callback. If true is returned from the
 // callback, iteration is halted.
@@ -165,17 +208,47 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// type. Coins returned by any registered LockedCoinsProviderFn are added on top.
+// The result is not capped at the account balance; callers computing spendable
+// coins must treat a denom locked beyond its balance as fully locked.
 func (k BaseViewKeeper) LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(ctx.BlockTime())
+			locked = vacc.LockedCoins(ctx.BlockTime())
 		}
 	}
 
-	return sdk.NewCoins()
+	if k.lockedCoinsProviders != nil {
+		for i, provider := range *k.lockedCoinsProviders {
+			locked = locked.Add(lockedByProvider(ctx, addr, i, provider)...)
+		}
+	}
+
+	return locked
+}
+
+// lockedByProvider returns the coins locked by the i-th registered provider,
+// sorted and without zero coins. It panics if the provider returns invalid
+// coins, e.g. negative amounts or duplicate denoms, since adding them to the
+// locked coins would silently corrupt the spendable balance.
+func lockedByProvider(ctx sdk.Context, addr sdk.AccAddress, i int, provider LockedCoinsProviderFn) sdk.Coins {
+	coins := sdk.Coins{}
+	for _, coin := range provider(ctx, addr) {
+		if !coin.IsZero() {
+			coins = append(coins, coin)
+		}
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		panic(fmt.Errorf("locked coins provider %d returned invalid coins %s for %s: %w", i, coins, addr, err))
+	}
+
+	return coins
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
@@ -192,10 +265,15 @@ func (k BaseViewKeeper) spendableCoins(ctx sdk.Context, addr sdk.AccAddress) (sp
 	total = k.GetAllBalances(ctx, addr)
 	locked := k.LockedCoins(ctx, addr)
 
-	spendable, hasNeg := total.SafeSub(locked)
-	if hasNeg {
-		spendable = sdk.NewCoins()
-		return
+	// Locked coins may exceed the balance of a denom, e.g. when a provider
+	// locks more than the account holds. Such a denom is fully locked but
+	// must not affect the spendable amount of the other denoms.
+	spendable = sdk.NewCoins()
+	for _, coin := range total {
+		lockedAmt := locked.AmountOf(coin.Denom)
+		if lockedAmt.LT(coin.Amount) {
+			spendable = append(spendable, sdk.NewCoin(coin.Denom, coin.Amount.Sub(lockedAmt)))
+		}
 	}
 
 	return
@@ -23,6 +23,17 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// supply change event name and attributes
+	EventTypeSupplyChange = "supply_change"
+
+	AttributeKeyDenom     = "denom"
+	AttributeKeyOldAmount = "old_amount"
+	AttributeKeyNewAmount = "new_amount"
+	AttributeKeyReason    = "reason"
+
+	AttributeValueReasonMint = "mint"
+	AttributeValueReasonBurn = "burn"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -64,3 +75,15 @@ func NewCoinBurnEvent(burner sdk.AccAddress, amount sdk.Coins) sdk.Event {
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 	)
 }
+
+// NewSupplyChangeEvent constructs a new supply change sdk.Event describing the
+// total supply of denom moving from oldAmount to newAmount for the given reason.
+func NewSupplyChangeEvent(denom string, oldAmount, newAmount sdk.Int, reason string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeSupplyChange,
+		sdk.NewAttribute(AttributeKeyDenom, denom),
+		sdk.NewAttribute(AttributeKeyOldAmount, oldAmount.String()),
+		sdk.NewAttribute(AttributeKeyNewAmount, newAmount.String()),
+		sdk.NewAttribute(AttributeKeyReason, reason),
+	)
+}
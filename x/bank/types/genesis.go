@@ -21,15 +21,23 @@ func (gs GenesisState) Validate() error {
 	totalSupply := sdk.Coins{}
 
 	for _, balance := range gs.Balances {
-		if seenBalances[balance.Address] {
+		// key on the address bytes, so that duplicates encoded differently,
+		// e.g. in upper case, are caught as well
+		addr, err := sdk.AccAddressFromBech32(balance.Address)
+		if err != nil {
+			return err
+		}
+
+		if seenBalances[string(addr)] {
 			return fmt.Errorf("duplicate balance for address %s", balance.Address)
 		}
 
-		if err := balance.Validate(); err != nil {
-			return err
+		// this also rejects duplicate denoms within the balance
+		if err := balance.Coins.Validate(); err != nil {
+			return fmt.Errorf("invalid balance for address %s: %w", balance.Address, err)
 		}
 
-		seenBalances[balance.Address] = true
+		seenBalances[string(addr)] = true
 
 		totalSupply = totalSupply.Add(balance.Coins...)
 	}
@@ -46,6 +54,23 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenSupplyCaps := make(map[string]bool)
+	for _, supplyCap := range gs.SupplyCaps {
+		if seenSupplyCaps[supplyCap.Denom] {
+			return fmt.Errorf("duplicate supply cap for denom %s", supplyCap.Denom)
+		}
+
+		if err := supplyCap.Validate(); err != nil {
+			return fmt.Errorf("invalid supply cap %s: %w", supplyCap, err)
+		}
+
+		if supply := totalSupply.AmountOf(supplyCap.Denom); supply.GT(supplyCap.Amount) {
+			return fmt.Errorf("supply cap %s is below the genesis supply %s%s", supplyCap, supply, supplyCap.Denom)
+		}
+
+		seenSupplyCaps[supplyCap.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
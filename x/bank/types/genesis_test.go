@@ -71,6 +71,34 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"dup balances with differently encoded addresses",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+					{
+						Address: "COSMOS1YQ8LGSSGXLX9SMJHES6RYJASMQMD3TS2559G0T",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 1)},
+					},
+				},
+			},
+			true,
+		},
+		{
+			"dup denoms in balance",
+			GenesisState{
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("uatom", 2)},
+					},
+				},
+			},
+			true,
+		},
 		{
 			"0  balance",
 			GenesisState{
@@ -141,6 +169,50 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid supply caps",
+			GenesisState{
+				Params: DefaultParams(),
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 10)},
+					},
+				},
+				SupplyCaps: sdk.Coins{sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uosmo", 5)},
+			},
+			false,
+		},
+		{
+			"duplicate supply caps",
+			GenesisState{
+				Params:     DefaultParams(),
+				SupplyCaps: sdk.Coins{sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uatom", 20)},
+			},
+			true,
+		},
+		{
+			"invalid supply cap",
+			GenesisState{
+				Params:     DefaultParams(),
+				SupplyCaps: sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdk.NewInt(-1)}},
+			},
+			true,
+		},
+		{
+			"supply cap below supply",
+			GenesisState{
+				Params: DefaultParams(),
+				Balances: []Balance{
+					{
+						Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+						Coins:   sdk.Coins{sdk.NewInt64Coin("uatom", 10)},
+					},
+				},
+				SupplyCaps: sdk.Coins{sdk.NewInt64Coin("uatom", 9)},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
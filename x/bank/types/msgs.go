@@ -10,6 +10,7 @@ import (
 const (
 	TypeMsgSend      = "send"
 	TypeMsgMultiSend = "multisend"
+	TypeMsgBurn      = "burn"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -102,6 +103,48 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+var _ sdk.Msg = &MsgBurn{}
+
+// NewMsgBurn - construct a msg to burn coins owned by an account.
+//nolint:interfacer
+func NewMsgBurn(fromAddr sdk.AccAddress, amount sdk.Coins) *MsgBurn {
+	return &MsgBurn{FromAddress: fromAddr.String(), Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
@@ -252,3 +252,41 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	atom123eth0 := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 0)}
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgBurn
+	}{
+		{"", NewMsgBurn(addr1, atom123)},                                // valid burn
+		{": invalid coins", NewMsgBurn(addr1, atom0)},                   // non positive coin
+		{"123atom,0eth: invalid coins", NewMsgBurn(addr1, atom123eth0)}, // non positive coin in multicoins
+		{"invalid from address: empty address string is not allowed: invalid address", NewMsgBurn(addrEmpty, atom123)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBurnGetSignBytes(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	var msg = NewMsgBurn(addr1, coins)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgBurn","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"cosmos1d9h8qat57ljhcm"}}`
+	require.Equal(t, expected, string(res))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	"sigs.k8s.io/yaml"
 
@@ -81,6 +82,66 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 	return NewParams(p.DefaultSendEnabled, sendParams)
 }
 
+// ParamsDiff describes the changes needed to turn one set of bank parameters
+// into another, e.g. to preview a parameter change proposal. SendEnabled
+// entries are ordered by denom.
+type ParamsDiff struct {
+	// DefaultSendEnabledChanged is true if DefaultSendEnabled differs.
+	DefaultSendEnabledChanged bool
+	// SendEnabledAdded holds the entries for denoms only present in the new params.
+	SendEnabledAdded SendEnabledParams
+	// SendEnabledRemoved holds the entries for denoms missing from the new params.
+	SendEnabledRemoved SendEnabledParams
+	// SendEnabledModified holds the new entries for denoms whose flag changed.
+	SendEnabledModified SendEnabledParams
+}
+
+// Empty returns true if the diff holds no changes.
+func (d ParamsDiff) Empty() bool {
+	return !d.DefaultSendEnabledChanged &&
+		len(d.SendEnabledAdded) == 0 &&
+		len(d.SendEnabledRemoved) == 0 &&
+		len(d.SendEnabledModified) == 0
+}
+
+// Diff returns the changes from p to other.
+func (p Params) Diff(other Params) ParamsDiff {
+	diff := ParamsDiff{
+		DefaultSendEnabledChanged: p.DefaultSendEnabled != other.DefaultSendEnabled,
+	}
+
+	current := make(map[string]bool, len(p.SendEnabled))
+	for _, se := range p.SendEnabled {
+		current[se.Denom] = se.Enabled
+	}
+
+	proposed := make(map[string]bool, len(other.SendEnabled))
+	for _, se := range other.SendEnabled {
+		proposed[se.Denom] = se.Enabled
+
+		enabled, ok := current[se.Denom]
+		switch {
+		case !ok:
+			diff.SendEnabledAdded = append(diff.SendEnabledAdded, NewSendEnabled(se.Denom, se.Enabled))
+		case enabled != se.Enabled:
+			diff.SendEnabledModified = append(diff.SendEnabledModified, NewSendEnabled(se.Denom, se.Enabled))
+		}
+	}
+
+	for _, se := range p.SendEnabled {
+		if _, ok := proposed[se.Denom]; !ok {
+			diff.SendEnabledRemoved = append(diff.SendEnabledRemoved, NewSendEnabled(se.Denom, se.Enabled))
+		}
+	}
+
+	for _, entries := range []SendEnabledParams{diff.SendEnabledAdded, diff.SendEnabledRemoved, diff.SendEnabledModified} {
+		entries := entries
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Denom < entries[j].Denom })
+	}
+
+	return diff
+}
+
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
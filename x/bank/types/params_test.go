@@ -112,3 +112,45 @@ func Test_validateParams(t *testing.T) {
 
 	require.Error(t, validateSendEnabledParams(SendEnabledParams{NewSendEnabled("INVALIDDENOM", true)}))
 }
+
+func TestParamsDiff(t *testing.T) {
+	current := NewParams(true, SendEnabledParams{
+		NewSendEnabled("foodenom", true),
+		NewSendEnabled("bardenom", false),
+		NewSendEnabled("bazdenom", true),
+	})
+
+	require.True(t, current.Diff(current).Empty())
+
+	// reordering entries is not a change
+	reordered := NewParams(true, SendEnabledParams{
+		NewSendEnabled("bazdenom", true),
+		NewSendEnabled("foodenom", true),
+		NewSendEnabled("bardenom", false),
+	})
+	require.True(t, current.Diff(reordered).Empty())
+
+	proposed := NewParams(false, SendEnabledParams{
+		NewSendEnabled("foodenom", false),
+		NewSendEnabled("quxdenom", false),
+		NewSendEnabled("bazdenom", true),
+		NewSendEnabled("abcdenom", true),
+	})
+
+	diff := current.Diff(proposed)
+	require.False(t, diff.Empty())
+	require.True(t, diff.DefaultSendEnabledChanged)
+	require.Equal(t, SendEnabledParams{
+		NewSendEnabled("abcdenom", true),
+		NewSendEnabled("quxdenom", false),
+	}, diff.SendEnabledAdded)
+	require.Equal(t, SendEnabledParams{NewSendEnabled("bardenom", false)}, diff.SendEnabledRemoved)
+	require.Equal(t, SendEnabledParams{NewSendEnabled("foodenom", false)}, diff.SendEnabledModified)
+
+	// the reverse diff swaps added and removed entries
+	reverse := proposed.Diff(current)
+	require.True(t, reverse.DefaultSendEnabledChanged)
+	require.Equal(t, diff.SendEnabledAdded, reverse.SendEnabledRemoved)
+	require.Equal(t, diff.SendEnabledRemoved, reverse.SendEnabledAdded)
+	require.Equal(t, SendEnabledParams{NewSendEnabled("foodenom", true)}, reverse.SendEnabledModified)
+}
@@ -25,6 +25,18 @@ func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *Qu
 	return &QueryAllBalancesRequest{Address: addr.String(), Pagination: req}
 }
 
+// NewQueryBalancesByDenomsRequest creates a new instance of QueryBalancesByDenomsRequest.
+//nolint:interfacer
+func NewQueryBalancesByDenomsRequest(addr sdk.AccAddress, denoms []string) *QueryBalancesByDenomsRequest {
+	return &QueryBalancesByDenomsRequest{Address: addr.String(), Denoms: denoms}
+}
+
+// NewQueryTransferAllowedRequest creates a new instance of QueryTransferAllowedRequest.
+//nolint:interfacer
+func NewQueryTransferAllowedRequest(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) *QueryTransferAllowedRequest {
+	return &QueryTransferAllowedRequest{FromAddress: fromAddr.String(), ToAddress: toAddr.String(), Amount: amount}
+}
+
 // QueryTotalSupplyParams defines the params for the following queries:
 //
 // - 'custom/bank/totalSupply'
@@ -13,14 +13,14 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	// NOTE the community pool isn't a module account, however its coins
 	// are held in the distribution module account. Thus the community pool
 	// must be reduced separately from the SendCoinsFromModuleToAccount call
-	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
-	if negative {
-		return types.ErrBadDistribution
+	newPool, err := communityPoolAfterSpend(feePool, amount)
+	if err != nil {
+		return err
 	}
 
 	feePool.CommunityPool = newPool
 
-	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiveAddr, amount)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiveAddr, amount)
 	if err != nil {
 		return err
 	}
@@ -28,3 +28,21 @@ func (k Keeper) DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// PreviewCommunityPoolAfterSpend returns the community pool balance that would
+// remain if amount were spent from it, e.g. by a passing community pool spend
+// proposal, without modifying state. Like DistributeFromFeePool, it returns
+// ErrBadDistribution if the community pool does not cover amount.
+func (k Keeper) PreviewCommunityPoolAfterSpend(ctx sdk.Context, amount sdk.Coins) (sdk.DecCoins, error) {
+	return communityPoolAfterSpend(k.GetFeePool(ctx), amount)
+}
+
+// communityPoolAfterSpend returns the community pool of feePool reduced by amount.
+func communityPoolAfterSpend(feePool types.FeePool, amount sdk.Coins) (sdk.DecCoins, error) {
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if negative {
+		return nil, types.ErrBadDistribution
+	}
+
+	return newPool, nil
+}
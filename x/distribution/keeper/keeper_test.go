@@ -129,3 +129,24 @@ func TestFundCommunityPool(t *testing.T) {
 	assert.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
 	assert.Empty(t, app.BankKeeper.GetAllBalances(ctx, addr[0]))
 }
+
+func TestPreviewCommunityPoolAfterSpend(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	pool := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1005, 1)), sdk.NewInt64DecCoin("foo", 50))
+	feePool := types.InitialFeePool()
+	feePool.CommunityPool = pool
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	spend := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+	preview, err := app.DistrKeeper.PreviewCommunityPoolAfterSpend(ctx, spend)
+	require.NoError(t, err)
+	require.Equal(t, pool.Sub(sdk.NewDecCoinsFromCoins(spend...)), preview)
+
+	// the pool itself is left unchanged
+	require.Equal(t, pool, app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+
+	_, err = app.DistrKeeper.PreviewCommunityPoolAfterSpend(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 101)))
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+}
@@ -26,7 +26,7 @@ func TestInitializeNodeValidatorFilesFromMnemonic(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.TestConfig()
-	cfg.RootDir = t.TempDir()
+	cfg.SetRoot(t.TempDir())
 	require.NoError(t, os.MkdirAll(filepath.Join(cfg.RootDir, "config"), 0755))
 
 	tests := []struct {